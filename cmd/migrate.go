@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"latihan-compro/config"
+	"latihan-compro/database/migrations"
+	"latihan-compro/utils/logger"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var migrateDir string
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "migrate",
+	Long:  `Apply, roll back and create the database migrations embedded in the binary.`,
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:          "up",
+	Short:        "Apply every pending migration",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		migrator, closeDB, err := newMigrator()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		applied, err := migrator.Up(cmd.Context())
+		for _, val := range applied {
+			fmt.Fprintf(cmd.OutOrStdout(), "applied %s\n", val)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Fprintln(cmd.OutOrStdout(), "no pending migration")
+		}
+		return err
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:          "down N",
+	Short:        "Roll back the N latest migrations",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return fmt.Errorf("N must be a positive number, got %q", args[0])
+		}
+
+		migrator, closeDB, err := newMigrator()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		rolledBack, err := migrator.Down(cmd.Context(), n)
+		for _, val := range rolledBack {
+			fmt.Fprintf(cmd.OutOrStdout(), "rolled back %s\n", val)
+		}
+		return err
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:          "status",
+	Short:        "List the migrations and when they were applied",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		migrator, closeDB, err := newMigrator()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		statuses, err := migrator.Status(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "MIGRATION\tAPPLIED AT")
+		for _, val := range statuses {
+			appliedAt := "pending"
+			if val.AppliedAt != nil {
+				appliedAt = val.AppliedAt.Local().Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", val.Migration, appliedAt)
+		}
+		return w.Flush()
+	},
+}
+
+var migrateCreateCmd = &cobra.Command{
+	Use:          "create NAME",
+	Short:        "Create an empty up and down migration",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		up, down, err := migrations.Create(migrateDir, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "created %s\ncreated %s\n", up, down)
+		return nil
+	},
+}
+
+// newMigrator connects to the configured database and returns a migrator of
+// the embedded migrations with a function closing the connection.
+func newMigrator() (migrations.MigratorInterface, func(), error) {
+	cfg := config.NewConfig()
+	logger.Init(cfg)
+
+	db, err := cfg.ConnectionPostgres()
+	if err != nil {
+		return nil, nil, err
+	}
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return migrations.NewMigrator(sqlDB, migrations.FS), func() { sqlDB.Close() }, nil
+}
+
+func init() {
+	migrateCreateCmd.Flags().StringVar(&migrateDir, "dir", migrations.Dir, "directory of the migration files")
+
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd, migrateCreateCmd)
+	rootCmd.AddCommand(migrateCmd)
+}
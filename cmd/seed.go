@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"latihan-compro/config"
+	"latihan-compro/database/seeds"
+	"latihan-compro/utils/logger"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var seedAdminName, seedAdminEmail, seedAdminPassword string
+
+var seedCmd = &cobra.Command{
+	Use:          "seed SET...",
+	Short:        "seed",
+	Long:         seedLong(),
+	Args:         cobra.MinimumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		list := make([]seeds.Set, 0, len(args))
+		for _, val := range args {
+			set, err := seeds.Lookup(val)
+			if err != nil {
+				return err
+			}
+			list = append(list, set)
+		}
+
+		cfg := config.NewConfig()
+		logger.Init(cfg)
+
+		opts := seeds.Options{
+			AdminName:     cfg.Seed.AdminName,
+			AdminEmail:    cfg.Seed.AdminEmail,
+			AdminPassword: cfg.Seed.AdminPassword,
+		}
+		if cmd.Flags().Changed("admin-name") {
+			opts.AdminName = seedAdminName
+		}
+		if cmd.Flags().Changed("admin-email") {
+			opts.AdminEmail = seedAdminEmail
+		}
+		if cmd.Flags().Changed("admin-password") {
+			opts.AdminPassword = seedAdminPassword
+		}
+
+		db, err := cfg.ConnectionPostgres()
+		if err != nil {
+			return err
+		}
+		sqlDB, err := db.DB.DB()
+		if err != nil {
+			return err
+		}
+		defer sqlDB.Close()
+
+		for _, set := range list {
+			inserted, err := seeds.Run(cmd.Context(), db.DB, set, opts)
+			if err != nil {
+				return fmt.Errorf("seed %s: %w", set.Name, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "seeded %d rows of %s\n", inserted, set.Name)
+		}
+		return nil
+	},
+}
+
+func seedLong() string {
+	var sb strings.Builder
+	sb.WriteString("Insert the rows of the named seed sets missing from the database, running a set again inserts nothing.\n\nSets:\n")
+	for _, val := range seeds.Sets() {
+		fmt.Fprintf(&sb, "  %-8s%s\n", val.Name, val.Description)
+	}
+	return sb.String()
+}
+
+func init() {
+	seedCmd.Flags().StringVar(&seedAdminName, "admin-name", "", "name of the admin user (default SEED_ADMIN_NAME or admin)")
+	seedCmd.Flags().StringVar(&seedAdminEmail, "admin-email", "", "email of the admin user (default SEED_ADMIN_EMAIL)")
+	seedCmd.Flags().StringVar(&seedAdminPassword, "admin-password", "", "password of the admin user (default SEED_ADMIN_PASSWORD)")
+
+	rootCmd.AddCommand(seedCmd)
+}
@@ -1,6 +1,12 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/viper"
+)
 
 type App struct {
 	AppPort string `json:"app_port"`
@@ -8,6 +14,40 @@ type App struct {
 
 	JwtSecretKey string `json:"jwt_secret_key"`
 	JwtIssuer    string `json:"jwt_issuer"`
+
+	AuditLogRetentionDays int `json:"audit_log_retention_days"`
+	TrashRetentionDays    int `json:"trash_retention_days"`
+
+	DefaultLocale    string   `json:"default_locale"`
+	SupportedLocales []string `json:"supported_locales"`
+
+	// RequestTimeout bounds every request, UploadTimeout the ones streaming
+	// files, which may take much longer.
+	RequestTimeout time.Duration `json:"request_timeout"`
+	UploadTimeout  time.Duration `json:"upload_timeout"`
+}
+
+// Locales returns the default locale and every supported locale, the default
+// one first. Content falls back to the default locale when untranslated.
+func (a App) Locales() (string, []string) {
+	defaultLocale := strings.ToLower(strings.TrimSpace(a.DefaultLocale))
+	if defaultLocale == "" {
+		defaultLocale = "id"
+	}
+
+	locales := []string{defaultLocale}
+	supported := a.SupportedLocales
+	if len(supported) == 0 {
+		supported = []string{"en"}
+	}
+	for _, val := range supported {
+		val = strings.ToLower(strings.TrimSpace(val))
+		if val == "" || val == defaultLocale {
+			continue
+		}
+		locales = append(locales, val)
+	}
+	return defaultLocale, locales
 }
 
 type PsqlDB struct {
@@ -24,6 +64,102 @@ type Supabase struct {
 	StorageUrl    string `json:"storage_url"`
 	StorageKey    string `json:"storage_key"`
 	StorageBucket string `json:"storage_bucket"`
+	// StoragePrivateBucket keeps the files under storage.PrivatePrefix, it
+	// must not be public. StorageBucket with a -private suffix by default.
+	StoragePrivateBucket string `json:"storage_private_bucket"`
+}
+
+type Storage struct {
+	Driver string `json:"driver"`
+
+	LocalPath      string `json:"local_path"`
+	LocalRoute     string `json:"local_route"`
+	LocalPublicUrl string `json:"local_public_url"`
+
+	S3Endpoint  string `json:"s3_endpoint"`
+	S3Region    string `json:"s3_region"`
+	S3AccessKey string `json:"s3_access_key"`
+	S3SecretKey string `json:"s3_secret_key"`
+	S3Bucket    string `json:"s3_bucket"`
+	S3UseSSL    bool   `json:"s3_use_ssl"`
+	S3PublicUrl string `json:"s3_public_url"`
+}
+
+type Upload struct {
+	// AllowedTypes maps every accepted MIME type to its maximum size in bytes.
+	AllowedTypes map[string]int64 `json:"allowed_types"`
+	// DocumentTypes does the same for service detail documents.
+	DocumentTypes map[string]int64 `json:"document_types"`
+	// MaxImagePixels bounds the width times height of processed images.
+	MaxImagePixels int64 `json:"max_image_pixels"`
+}
+
+type Download struct {
+	// SigningKey signs document download urls, the JWT secret when unset.
+	SigningKey string        `json:"signing_key"`
+	UrlTTL     time.Duration `json:"url_ttl"`
+	// BaseUrl prefixes signed download urls, they are relative when unset.
+	BaseUrl string `json:"base_url"`
+}
+
+type Cache struct {
+	// Driver is memory, redis or none, memory by default.
+	Driver string `json:"driver"`
+	// TTL is how long a public response is kept server side.
+	TTL time.Duration `json:"ttl"`
+	// MaxAge is the Cache-Control max-age sent to clients.
+	MaxAge     time.Duration `json:"max_age"`
+	MaxEntries int           `json:"max_entries"`
+
+	RedisAddr     string `json:"redis_addr"`
+	RedisPassword string `json:"redis_password"`
+	RedisDB       int    `json:"redis_db"`
+	RedisPrefix   string `json:"redis_prefix"`
+}
+
+type Log struct {
+	// Level is a zerolog level name, info by default.
+	Level string `json:"level"`
+	// Format is json or console, json by default.
+	Format string `json:"format"`
+	// AccessBody adds the request and response bodies, with sensitive fields
+	// redacted, to access logs.
+	AccessBody bool `json:"access_body"`
+}
+
+type Metrics struct {
+	// Token, when set, must be sent as a bearer token to read /metrics.
+	Token string `json:"token"`
+}
+
+type Health struct {
+	// CheckTimeout bounds each readiness check, two seconds by default.
+	CheckTimeout time.Duration `json:"check_timeout"`
+	// CacheTTL reuses a readiness report for this long, a second by default.
+	CacheTTL time.Duration `json:"cache_ttl"`
+	// ShutdownDelay keeps serving, with readiness failing, for this long after
+	// a shutdown signal so load balancers stop routing to the server first.
+	ShutdownDelay time.Duration `json:"shutdown_delay"`
+}
+
+type Seed struct {
+	AdminName     string `json:"admin_name"`
+	AdminEmail    string `json:"admin_email"`
+	AdminPassword string `json:"admin_password"`
+}
+
+type Tracing struct {
+	// Exporter is otlp, stdout, file or none, none by default. Spans are
+	// created either way, their trace ids correlate logs.
+	Exporter string `json:"exporter"`
+	// OtlpEndpoint is the collector url, like http://localhost:4318, the
+	// OTEL_EXPORTER_OTLP_ENDPOINT env is used when unset.
+	OtlpEndpoint string `json:"otlp_endpoint"`
+	// File receives the spans of the file exporter, one json object each.
+	File string `json:"file"`
+	// SampleRatio is the share of new traces recorded, all when unset.
+	SampleRatio float64 `json:"sample_ratio"`
+	ServiceName string  `json:"service_name"`
 }
 
 type EmailConfig struct {
@@ -39,6 +175,15 @@ type Config struct {
 	App      App
 	Psql     PsqlDB
 	Supabase Supabase
+	Storage  Storage
+	Upload   Upload
+	Download Download
+	Cache    Cache
+	Log      Log
+	Metrics  Metrics
+	Tracing  Tracing
+	Health   Health
+	Seed     Seed
 	Email    EmailConfig
 }
 
@@ -50,6 +195,15 @@ func NewConfig() *Config {
 
 			JwtSecretKey: viper.GetString("JWT_SECRET_KEY"),
 			JwtIssuer:    viper.GetString("JWT_ISSUER"),
+
+			AuditLogRetentionDays: viper.GetInt("AUDIT_LOG_RETENTION_DAYS"),
+			TrashRetentionDays:    viper.GetInt("TRASH_RETENTION_DAYS"),
+
+			DefaultLocale:    viper.GetString("DEFAULT_LOCALE"),
+			SupportedLocales: splitList(viper.GetString("SUPPORTED_LOCALES")),
+
+			RequestTimeout: viper.GetDuration("REQUEST_TIMEOUT"),
+			UploadTimeout:  viper.GetDuration("REQUEST_UPLOAD_TIMEOUT"),
 		},
 		Psql: PsqlDB{
 			Host:      viper.GetString("DATABASE_HOST"),
@@ -61,9 +215,71 @@ func NewConfig() *Config {
 			DBMaxIdle: viper.GetInt("DATABASE_MAX_IDLE_CONNECTION"),
 		},
 		Supabase: Supabase{
-			StorageUrl:    viper.GetString("SUPABASE_STORAGE_URL"),
-			StorageKey:    viper.GetString("SUPABASE_STORAGE_KEY"),
-			StorageBucket: viper.GetString("SUPABASE_STORAGE_BUCKET"),
+			StorageUrl:           viper.GetString("SUPABASE_STORAGE_URL"),
+			StorageKey:           viper.GetString("SUPABASE_STORAGE_KEY"),
+			StorageBucket:        viper.GetString("SUPABASE_STORAGE_BUCKET"),
+			StoragePrivateBucket: viper.GetString("SUPABASE_STORAGE_PRIVATE_BUCKET"),
+		},
+		Storage: Storage{
+			Driver: viper.GetString("STORAGE_DRIVER"),
+
+			LocalPath:      viper.GetString("STORAGE_LOCAL_PATH"),
+			LocalRoute:     viper.GetString("STORAGE_LOCAL_ROUTE"),
+			LocalPublicUrl: viper.GetString("STORAGE_LOCAL_PUBLIC_URL"),
+
+			S3Endpoint:  viper.GetString("STORAGE_S3_ENDPOINT"),
+			S3Region:    viper.GetString("STORAGE_S3_REGION"),
+			S3AccessKey: viper.GetString("STORAGE_S3_ACCESS_KEY"),
+			S3SecretKey: viper.GetString("STORAGE_S3_SECRET_KEY"),
+			S3Bucket:    viper.GetString("STORAGE_S3_BUCKET"),
+			S3UseSSL:    viper.GetBool("STORAGE_S3_USE_SSL"),
+			S3PublicUrl: viper.GetString("STORAGE_S3_PUBLIC_URL"),
+		},
+		Upload: Upload{
+			AllowedTypes:   parseSizeLimits(viper.GetString("UPLOAD_ALLOWED_TYPES")),
+			DocumentTypes:  parseSizeLimits(viper.GetString("UPLOAD_DOCUMENT_TYPES")),
+			MaxImagePixels: viper.GetInt64("UPLOAD_MAX_IMAGE_PIXELS"),
+		},
+		Download: Download{
+			SigningKey: viper.GetString("DOWNLOAD_SIGNING_KEY"),
+			UrlTTL:     viper.GetDuration("DOWNLOAD_URL_TTL"),
+			BaseUrl:    viper.GetString("DOWNLOAD_BASE_URL"),
+		},
+		Cache: Cache{
+			Driver:     viper.GetString("CACHE_DRIVER"),
+			TTL:        viper.GetDuration("CACHE_TTL"),
+			MaxAge:     viper.GetDuration("CACHE_MAX_AGE"),
+			MaxEntries: viper.GetInt("CACHE_MAX_ENTRIES"),
+
+			RedisAddr:     viper.GetString("CACHE_REDIS_ADDR"),
+			RedisPassword: viper.GetString("CACHE_REDIS_PASSWORD"),
+			RedisDB:       viper.GetInt("CACHE_REDIS_DB"),
+			RedisPrefix:   viper.GetString("CACHE_REDIS_PREFIX"),
+		},
+		Log: Log{
+			Level:      viper.GetString("LOG_LEVEL"),
+			Format:     viper.GetString("LOG_FORMAT"),
+			AccessBody: viper.GetBool("LOG_ACCESS_BODY"),
+		},
+		Metrics: Metrics{
+			Token: viper.GetString("METRICS_TOKEN"),
+		},
+		Tracing: Tracing{
+			Exporter:     viper.GetString("TRACING_EXPORTER"),
+			OtlpEndpoint: viper.GetString("TRACING_OTLP_ENDPOINT"),
+			File:         viper.GetString("TRACING_FILE"),
+			SampleRatio:  viper.GetFloat64("TRACING_SAMPLE_RATIO"),
+			ServiceName:  viper.GetString("TRACING_SERVICE_NAME"),
+		},
+		Health: Health{
+			CheckTimeout:  viper.GetDuration("HEALTH_CHECK_TIMEOUT"),
+			CacheTTL:      viper.GetDuration("HEALTH_CACHE_TTL"),
+			ShutdownDelay: viper.GetDuration("HEALTH_SHUTDOWN_DELAY"),
+		},
+		Seed: Seed{
+			AdminName:     viper.GetString("SEED_ADMIN_NAME"),
+			AdminEmail:    viper.GetString("SEED_ADMIN_EMAIL"),
+			AdminPassword: viper.GetString("SEED_ADMIN_PASSWORD"),
 		},
 		Email: EmailConfig{
 			Host:     viper.GetString("EMAIL_HOST"),
@@ -75,3 +291,30 @@ func NewConfig() *Config {
 		},
 	}
 }
+
+// splitList splits a comma separated env value, returning nil when it is unset.
+func splitList(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// parseSizeLimits parses a list like "image/png=5MB,video/mp4=50MB". Entries
+// with an invalid size are skipped.
+func parseSizeLimits(value string) map[string]int64 {
+	limits := map[string]int64{}
+	for _, val := range splitList(value) {
+		mimeType, size, ok := strings.Cut(val, "=")
+		if !ok {
+			continue
+		}
+
+		bytes, err := humanize.ParseBytes(strings.TrimSpace(size))
+		if err != nil {
+			continue
+		}
+		limits[strings.ToLower(strings.TrimSpace(mimeType))] = int64(bytes)
+	}
+	return limits
+}
@@ -2,7 +2,6 @@ package config
 
 import (
 	"fmt"
-	"latihan-compro/database/seeds"
 
 	"github.com/rs/zerolog/log"
 	"gorm.io/driver/postgres"
@@ -13,6 +12,8 @@ type Postgres struct {
 	DB *gorm.DB
 }
 
+// ConnectionPostgres connects to the database without touching it, tables are
+// created by the migrate command and content by the seed command.
 func (cfg Config) ConnectionPostgres() (*Postgres, error) {
 	dbConnString := fmt.Sprintf("postgres://%s:%s@%s:%s/%s",
 		cfg.Psql.User,
@@ -33,8 +34,6 @@ func (cfg Config) ConnectionPostgres() (*Postgres, error) {
 		return nil, err
 	}
 
-	seeds.SeedAdmin(db)
-
 	sqlDB.SetMaxOpenConns(cfg.Psql.DBMaxOpen)
 	sqlDB.SetMaxIdleConns(cfg.Psql.DBMaxIdle)
 
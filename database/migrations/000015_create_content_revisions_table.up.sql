@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS content_revisions (
+    id SERIAL PRIMARY KEY,
+    content_type varchar(100) NOT NULL,
+    content_id INT NOT NULL,
+    version INT NOT NULL,
+    snapshot jsonb NOT NULL,
+    author_id INT NULL REFERENCES users(id) ON DELETE SET NULL,
+    note varchar(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_content_revisions_content_version ON content_revisions(content_type, content_id, version);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id SERIAL PRIMARY KEY,
+    actor_id INT NULL,
+    action varchar(20) NOT NULL,
+    entity_type varchar(100) NOT NULL,
+    entity_id INT NULL,
+    before_value jsonb NULL,
+    after_value jsonb NULL,
+    ip_address varchar(45),
+    user_agent text,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_audit_logs_created_at ON audit_logs(created_at);
+CREATE INDEX idx_audit_logs_actor_id ON audit_logs(actor_id);
+CREATE INDEX idx_audit_logs_entity ON audit_logs(entity_type, entity_id);
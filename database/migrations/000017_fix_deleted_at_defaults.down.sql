@@ -0,0 +1,14 @@
+ALTER TABLE "users" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "hero_sections" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "client_sections" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "faq_sections" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "about_companies" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "about_company_keynotes" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "service_sections" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "appointments" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "portofolio_sections" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "portofolio_details" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "portofolio_testimonials" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "our_teams" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "contact_us" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "service_details" ALTER COLUMN deleted_at SET DEFAULT CURRENT_TIMESTAMP;
@@ -0,0 +1,5 @@
+ALTER TABLE client_sections DROP COLUMN IF EXISTS position;
+ALTER TABLE our_teams DROP COLUMN IF EXISTS position;
+ALTER TABLE service_sections DROP COLUMN IF EXISTS position;
+ALTER TABLE faq_sections DROP COLUMN IF EXISTS position;
+ALTER TABLE portofolio_sections DROP COLUMN IF EXISTS position;
@@ -0,0 +1,18 @@
+ALTER TABLE client_sections ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+ALTER TABLE our_teams ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+ALTER TABLE service_sections ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+ALTER TABLE faq_sections ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+ALTER TABLE portofolio_sections ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+
+-- Keep the current (creation) order as the initial manual order.
+UPDATE client_sections SET position = ranked.rn FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS rn FROM client_sections) ranked WHERE client_sections.id = ranked.id;
+UPDATE our_teams SET position = ranked.rn FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS rn FROM our_teams) ranked WHERE our_teams.id = ranked.id;
+UPDATE service_sections SET position = ranked.rn FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS rn FROM service_sections) ranked WHERE service_sections.id = ranked.id;
+UPDATE faq_sections SET position = ranked.rn FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS rn FROM faq_sections) ranked WHERE faq_sections.id = ranked.id;
+UPDATE portofolio_sections SET position = ranked.rn FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS rn FROM portofolio_sections) ranked WHERE portofolio_sections.id = ranked.id;
+
+CREATE INDEX idx_client_sections_position ON client_sections(position);
+CREATE INDEX idx_our_teams_position ON our_teams(position);
+CREATE INDEX idx_service_sections_position ON service_sections(position);
+CREATE INDEX idx_faq_sections_position ON faq_sections(position);
+CREATE INDEX idx_portofolio_sections_position ON portofolio_sections(position);
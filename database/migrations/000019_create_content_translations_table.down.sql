@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "content_translations";
\ No newline at end of file
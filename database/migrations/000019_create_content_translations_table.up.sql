@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS content_translations (
+    id SERIAL PRIMARY KEY,
+    content_type varchar(100) NOT NULL,
+    content_id INT NOT NULL,
+    locale varchar(10) NOT NULL,
+    field varchar(100) NOT NULL,
+    value text NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+CREATE UNIQUE INDEX idx_content_translations_field ON content_translations(content_type, content_id, locale, field);
+CREATE INDEX idx_content_translations_locale ON content_translations(content_type, locale);
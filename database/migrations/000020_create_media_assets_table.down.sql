@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "media_assets";
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS media_assets (
+    id SERIAL PRIMARY KEY,
+    path varchar(255) NOT NULL,
+    url text NOT NULL,
+    content_type varchar(100) NOT NULL,
+    size BIGINT NOT NULL,
+    width INT NULL,
+    height INT NULL,
+    variant_paths jsonb NOT NULL DEFAULT '[]',
+    uploader_id INT NULL REFERENCES users(id) ON DELETE SET NULL,
+    alt_text varchar(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+CREATE UNIQUE INDEX idx_media_assets_url ON media_assets(url);
+CREATE INDEX idx_media_assets_created_at ON media_assets(created_at);
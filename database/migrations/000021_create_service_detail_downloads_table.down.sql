@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "service_detail_downloads";
\ No newline at end of file
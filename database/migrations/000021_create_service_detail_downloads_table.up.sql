@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS service_detail_downloads (
+    id SERIAL PRIMARY KEY,
+    service_detail_id INT NOT NULL REFERENCES service_details(id) ON DELETE CASCADE,
+    file_type varchar(10) NOT NULL,
+    total BIGINT NOT NULL DEFAULT 0,
+    last_downloaded_at TIMESTAMP NULL
+);
+
+CREATE UNIQUE INDEX idx_service_detail_downloads_detail_type ON service_detail_downloads(service_detail_id, file_type);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "chunked_uploads";
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS chunked_uploads (
+    id varchar(36) PRIMARY KEY,
+    uploader_id INT NULL REFERENCES users(id) ON DELETE SET NULL,
+    file_name varchar(255) NULL,
+    length BIGINT NOT NULL,
+    upload_offset BIGINT NOT NULL DEFAULT 0,
+    chunk_paths jsonb NOT NULL DEFAULT '[]',
+    content_type varchar(100) NULL,
+    extension varchar(20) NULL,
+    media_asset_id INT NULL REFERENCES media_assets(id) ON DELETE SET NULL,
+    completed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL
+);
+
+CREATE INDEX idx_chunked_uploads_created_at ON chunked_uploads(created_at);
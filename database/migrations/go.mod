@@ -1,3 +0,0 @@
-module latihan_compro
-
-go 1.24.0
@@ -0,0 +1,132 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Dir is where migrate create writes new migrations, relative to the
+// repository root.
+const Dir = "database/migrations"
+
+//go:embed *.sql
+var files embed.FS
+
+// FS holds the migrations embedded in the binary, the server and the migrate
+// command run from it so no sql file has to be shipped.
+var FS fs.FS = files
+
+// migrationFile matches 000001_create_users_table.up.sql and its down file.
+var migrationFile = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+var nameInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Migration is one numbered pair of up and down sql files.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// String returns the migration as its files are named, 000001_name.
+func (m Migration) String() string {
+	return fmt.Sprintf("%06d_%s", m.Version, m.Name)
+}
+
+// Load reads the migrations of source sorted by version. Every migration needs
+// an up file, two migrations may not share a version.
+func Load(source fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(source, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", entry.Name(), err)
+		}
+		content, err := fs.ReadFile(source, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migrations %s and %06d_%s share a version", entry.Name(), version, migration.Name)
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, val := range byVersion {
+		if strings.TrimSpace(val.Up) == "" {
+			return nil, fmt.Errorf("migration %s has no up file", val)
+		}
+		migrations = append(migrations, *val)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Create writes an empty up and down migration named name to dir, numbered
+// after the latest one there, and returns their paths.
+func Create(dir, name string) (string, string, error) {
+	name = strings.Trim(nameInvalid.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return "", "", fmt.Errorf("migration name must contain letters or digits")
+	}
+
+	existing, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+	version := int64(1)
+	if len(existing) > 0 {
+		version = existing[len(existing)-1].Version + 1
+	}
+
+	migration := Migration{Version: version, Name: name}
+	up := filepath.Join(dir, migration.String()+".up.sql")
+	down := filepath.Join(dir, migration.String()+".down.sql")
+	for path, direction := range map[string]string{up: "up", down: "down"} {
+		// O_EXCL, a migration is never overwritten.
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err != nil {
+			return "", "", err
+		}
+		_, err = fmt.Fprintf(file, "-- %s %s migration.\n", migration, direction)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return "", "", err
+		}
+	}
+	return up, down, nil
+}
@@ -0,0 +1,68 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := Load(FS)
+	if err != nil {
+		t.Fatalf("Load(FS) = %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("no migration is embedded")
+	}
+
+	for i, val := range migrations {
+		if val.Version != int64(i+1) {
+			t.Errorf("migration %s is numbered %d, want %d", val, val.Version, i+1)
+		}
+		if strings.TrimSpace(val.Down) == "" {
+			t.Errorf("migration %s has no down file", val)
+		}
+	}
+}
+
+func TestLoadRejectsBrokenMigrations(t *testing.T) {
+	tests := map[string]fstest.MapFS{
+		"shared version": {
+			"000001_create_users.up.sql": {Data: []byte("SELECT 1;")},
+			"000001_create_posts.up.sql": {Data: []byte("SELECT 1;")},
+		},
+		"down without up": {
+			"000001_create_users.down.sql": {Data: []byte("SELECT 1;")},
+		},
+	}
+	for name, source := range tests {
+		if _, err := Load(source); err == nil {
+			t.Errorf("Load() of %s = nil, want an error", name)
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "000007_create_users_table.up.sql"), []byte("SELECT 1;"), 0o644)
+	os.WriteFile(filepath.Join(dir, "000007_create_users_table.down.sql"), []byte("SELECT 1;"), 0o644)
+
+	up, down, err := Create(dir, "Add Slug-To Posts")
+	if err != nil {
+		t.Fatalf("Create() = %v", err)
+	}
+	if filepath.Base(up) != "000008_add_slug_to_posts.up.sql" || filepath.Base(down) != "000008_add_slug_to_posts.down.sql" {
+		t.Errorf("Create() = %s, %s", up, down)
+	}
+
+	migrations, err := Load(os.DirFS(dir))
+	if err != nil || len(migrations) != 2 {
+		t.Fatalf("Load() after Create() = %v, %v", migrations, err)
+	}
+
+	if _, _, err := Create(dir, "--"); err == nil {
+		t.Error("Create() with an empty name = nil, want an error")
+	}
+}
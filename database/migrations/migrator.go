@@ -0,0 +1,284 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"time"
+)
+
+// lockKey is the postgres advisory lock held while migrating, replicas started
+// together wait on it in turn instead of applying the same migrations.
+const lockKey int64 = 0x636f6d70726f // "compro"
+
+const createVersionTable = `CREATE TABLE IF NOT EXISTS schema_versions (
+	version BIGINT PRIMARY KEY,
+	name TEXT NOT NULL,
+	applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+var ErrNoDownMigration = errors.New("migration has no down file")
+
+// Status is a migration and when it was applied, nil while pending.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type MigratorInterface interface {
+	// Up applies every pending migration and returns them.
+	Up(ctx context.Context) ([]Migration, error)
+	// Down rolls back the n latest applied migrations and returns them.
+	Down(ctx context.Context, n int) ([]Migration, error)
+	Status(ctx context.Context) ([]Status, error)
+	Pending(ctx context.Context) ([]Migration, error)
+}
+
+type migrator struct {
+	db     *sql.DB
+	source fs.FS
+}
+
+// Up implements MigratorInterface.
+// Each migration runs in its own transaction with the row recording it, a
+// failing one leaves the schema at the previous version.
+func (m *migrator) Up(ctx context.Context) ([]Migration, error) {
+	migrations, err := Load(m.source)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	err = m.withLock(ctx, migrations, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, val := range migrations {
+			if _, ok := versions[val.Version]; ok {
+				continue
+			}
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, val.Up); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, "INSERT INTO schema_versions (version, name) VALUES ($1, $2)", val.Version, val.Name)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("applying %s: %w", val, err)
+			}
+			applied = append(applied, val)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down implements MigratorInterface.
+func (m *migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("rolling back %d migrations: the count must be positive", n)
+	}
+
+	migrations, err := Load(m.source)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int64]Migration{}
+	for _, val := range migrations {
+		byVersion[val.Version] = val
+	}
+
+	var rolledBack []Migration
+	err = m.withLock(ctx, migrations, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		latest := make([]int64, 0, len(versions))
+		for version := range versions {
+			latest = append(latest, version)
+		}
+		sort.Slice(latest, func(i, j int) bool { return latest[i] > latest[j] })
+
+		for _, version := range latest[:min(n, len(latest))] {
+			val, ok := byVersion[version]
+			if !ok {
+				return fmt.Errorf("rolling back %06d: the migration is not in this build", version)
+			}
+			if val.Down == "" {
+				return fmt.Errorf("rolling back %s: %w", val, ErrNoDownMigration)
+			}
+
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, val.Down); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, "DELETE FROM schema_versions WHERE version = $1", val.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("rolling back %s: %w", val, err)
+			}
+			rolledBack = append(rolledBack, val)
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// Status implements MigratorInterface.
+// It only reads, a database never migrated has every migration pending.
+func (m *migrator) Status(ctx context.Context) ([]Status, error) {
+	migrations, err := Load(m.source)
+	if err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	if err := m.db.QueryRowContext(ctx, "SELECT to_regclass('schema_versions') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, err
+	}
+	versions := map[int64]time.Time{}
+	if exists {
+		if versions, err = appliedVersions(ctx, m.db); err != nil {
+			return nil, err
+		}
+	}
+
+	statuses := make([]Status, 0, len(migrations))
+	for _, val := range migrations {
+		status := Status{Migration: val}
+		if appliedAt, ok := versions[val.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Pending implements MigratorInterface.
+func (m *migrator) Pending(ctx context.Context) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, val := range statuses {
+		if val.AppliedAt == nil {
+			pending = append(pending, val.Migration)
+		}
+	}
+	return pending, nil
+}
+
+// withLock runs fn on one connection holding the advisory lock, advisory locks
+// belong to the session that took them. The version table is created first.
+func (m *migrator) withLock(ctx context.Context, migrations []Migration, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("taking the migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey)
+
+	if _, err := conn.ExecContext(ctx, createVersionTable); err != nil {
+		return err
+	}
+	if err := adoptGolangMigrate(ctx, conn, migrations); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+// adoptGolangMigrate records the migrations an earlier golang-migrate run
+// applied, it only kept the latest version in schema_migrations.
+func adoptGolangMigrate(ctx context.Context, conn *sql.Conn, migrations []Migration) error {
+	var recorded, legacy bool
+	err := conn.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM schema_versions), to_regclass('schema_migrations') IS NOT NULL").Scan(&recorded, &legacy)
+	if err != nil || recorded || !legacy {
+		return err
+	}
+
+	var (
+		version int64
+		dirty   bool
+	)
+	err = conn.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("golang-migrate left migration %06d half applied, fix it by hand first", version)
+	}
+
+	return inTx(ctx, conn, func(tx *sql.Tx) error {
+		for _, val := range migrations {
+			if val.Version > version {
+				break
+			}
+			if _, err := tx.ExecContext(ctx, "INSERT INTO schema_versions (version, name) VALUES ($1, $2)", val.Version, val.Name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func appliedVersions(ctx context.Context, db querier) (map[int64]time.Time, error) {
+	rows, err := db.QueryContext(ctx, "SELECT version, applied_at FROM schema_versions")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := map[int64]time.Time{}
+	for rows.Next() {
+		var (
+			version   int64
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		versions[version] = appliedAt
+	}
+	return versions, rows.Err()
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// NewMigrator runs the migrations of source, FS in the server, against db.
+func NewMigrator(db *sql.DB, source fs.FS) MigratorInterface {
+	return &migrator{
+		db:     db,
+		source: source,
+	}
+}
@@ -0,0 +1,271 @@
+package seeds
+
+import (
+	"context"
+	"fmt"
+	"latihan-compro/internal/core/domain/model"
+	"net/url"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// demoImage returns a placeholder image url showing text.
+func demoImage(size, text string) string {
+	return "https://placehold.co/" + size + "?text=" + url.QueryEscape(text)
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
+
+// seedDemo fills every home page section with the content of a fictional
+// software agency. Rows are matched by title or name, edited demo rows are
+// left as they are.
+func seedDemo(ctx context.Context, db *gorm.DB, opts Options) (int, error) {
+	var inserted int
+	for _, seed := range []func(db *gorm.DB, inserted *int) error{
+		seedDemoHero,
+		seedDemoClients,
+		seedDemoAbout,
+		seedDemoServices,
+		seedDemoPortofolios,
+		seedDemoTeam,
+		seedDemoFaq,
+		seedDemoContact,
+	} {
+		if err := seed(db, &inserted); err != nil {
+			return inserted, err
+		}
+	}
+	return inserted, nil
+}
+
+func seedDemoHero(db *gorm.DB, inserted *int) error {
+	heading := "We build digital products that grow your business"
+	return firstOrCreate(db, model.HeroSection{Heading: heading}, &model.HeroSection{
+		Heading:    heading,
+		SubHeading: "Strategy, design and engineering under one roof since 2014",
+		PathBanner: demoImage("1920x1080", "Hero Banner"),
+	}, inserted)
+}
+
+func seedDemoClients(db *gorm.DB, inserted *int) error {
+	for i, name := range []string{"Bank Nusantara", "Kopi Senja", "Garuda Logistics", "Sehat Clinic", "Tani Makmur", "Edukasi Kita"} {
+		row := model.ClientSection{
+			Name:     name,
+			PathIcon: demoImage("240x120", name),
+			Position: int64(i + 1),
+		}
+		if err := firstOrCreate(db, model.ClientSection{Name: name}, &row, inserted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seedDemoAbout(db *gorm.DB, inserted *int) error {
+	description := "Arunika Digital is a team of 40 designers and engineers in Jakarta and Yogyakarta. " +
+		"We partner with banks, retailers and startups to plan, build and run the software their customers use every day."
+	about := model.AboutCompany{Description: description}
+	if err := firstOrCreate(db, model.AboutCompany{Description: description}, &about, inserted); err != nil {
+		return err
+	}
+
+	for i, keypoint := range []string{
+		"Over 120 products shipped for clients in 9 industries",
+		"Dedicated squads of product, design and engineering",
+		"ISO 27001 certified delivery and hosting practices",
+	} {
+		row := model.AboutCompanyKeynote{
+			AboutCompanyID: about.ID,
+			Keypoint:       keypoint,
+			PathImage:      ptr(demoImage("600x400", fmt.Sprintf("Keynote %d", i+1))),
+		}
+		if err := firstOrCreate(db, model.AboutCompanyKeynote{AboutCompanyID: about.ID, Keypoint: keypoint}, &row, inserted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seedDemoServices(db *gorm.DB, inserted *int) error {
+	services := []struct {
+		name, tagline, title, description string
+	}{
+		{
+			"Web Development", "Fast, accessible websites and web apps",
+			"Web applications built to scale",
+			"From company profiles to customer portals, we build responsive web applications with Go and React, tested, monitored and ready for millions of visitors.",
+		},
+		{
+			"Mobile Apps", "Native quality on iOS and Android",
+			"Mobile apps your customers keep",
+			"We design and build Flutter and native apps, publish them to the stores and keep them fast with crash reporting and staged releases.",
+		},
+		{
+			"UI/UX Design", "Research led product design",
+			"Design grounded in research",
+			"Interviews, prototypes and usability tests turn your goals into interfaces people understand on the first try, delivered as a documented design system.",
+		},
+		{
+			"Cloud & DevOps", "Reliable infrastructure you can afford",
+			"Infrastructure that stays up",
+			"We move workloads to the cloud, automate deployments and set up monitoring so releases are routine and outages are rare.",
+		},
+	}
+
+	for i, val := range services {
+		section := model.ServiceSection{
+			Name:     val.name,
+			Tagline:  val.tagline,
+			PathIcon: demoImage("128x128", val.name),
+			Position: int64(i + 1),
+		}
+		if err := firstOrCreate(db, model.ServiceSection{Name: val.name}, &section, inserted); err != nil {
+			return err
+		}
+
+		detail := model.ServiceDetail{
+			ServiceID:   section.ID,
+			Title:       val.title,
+			Description: val.description,
+			PathImage:   demoImage("1200x800", val.name),
+		}
+		if err := firstOrCreate(db, model.ServiceDetail{ServiceID: section.ID, Title: val.title}, &detail, inserted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seedDemoPortofolios(db *gorm.DB, inserted *int) error {
+	portofolios := []struct {
+		name, tagline, category, client, title, description, url string
+		date                                                     time.Time
+		testimonial, author, role                                string
+	}{
+		{
+			"Nusantara Mobile Banking", "A banking app for 2 million customers", "Mobile App", "Bank Nusantara",
+			"Rebuilding mobile banking from the ground up",
+			"We replaced a ten year old app with a Flutter app and a Go API gateway, cutting login time from 9 to 2 seconds and raising the store rating from 2.8 to 4.6.",
+			"https://example.com/nusantara-mobile",
+			time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC),
+			"Arunika understood our regulators as well as our customers. The new app launched on schedule and support tickets dropped by half.",
+			"Rina Wijaya", "Head of Digital Banking",
+		},
+		{
+			"Kopi Senja Ordering", "Order ahead for 80 coffee shops", "Web Application", "Kopi Senja",
+			"Order ahead and loyalty in one web app",
+			"A progressive web app lets customers order ahead and collect points in every outlet, while baristas see orders on a kitchen display.",
+			"https://example.com/kopi-senja",
+			time.Date(2023, time.September, 4, 0, 0, 0, 0, time.UTC),
+			"Morning queues are gone and a third of our orders now come through the app. The team felt like part of ours.",
+			"Andi Pratama", "Founder",
+		},
+		{
+			"Garuda Fleet Tracking", "Real time tracking of 1,500 trucks", "Cloud Platform", "Garuda Logistics",
+			"Live fleet tracking on a budget",
+			"GPS events from every truck stream into a dashboard showing delays before they happen, running on autoscaled cloud infrastructure for a fraction of the old cost.",
+			"https://example.com/garuda-fleet",
+			time.Date(2024, time.November, 20, 0, 0, 0, 0, time.UTC),
+			"We finally know where every shipment is. Arunika delivered a platform our own engineers can run.",
+			"Budi Santoso", "Chief Operating Officer",
+		},
+	}
+
+	for i, val := range portofolios {
+		section := model.PortofolioSection{
+			Name:      val.name,
+			Tagline:   val.tagline,
+			Thumbnail: ptr(demoImage("800x600", val.client)),
+			Position:  int64(i + 1),
+		}
+		if err := firstOrCreate(db, model.PortofolioSection{Name: val.name}, &section, inserted); err != nil {
+			return err
+		}
+
+		detail := model.PortofolioDetail{
+			PortofolioSectionID: section.ID,
+			Category:            val.category,
+			ClientName:          val.client,
+			ProjectDate:         val.date,
+			ProjectUrl:          ptr(val.url),
+			Title:               val.title,
+			Description:         val.description,
+		}
+		if err := firstOrCreate(db, model.PortofolioDetail{PortofolioSectionID: section.ID, Title: val.title}, &detail, inserted); err != nil {
+			return err
+		}
+
+		testimonial := model.PortofolioTestimonial{
+			PortofolioSectionID: section.ID,
+			Thumbnail:           demoImage("160x160", val.author),
+			Message:             val.testimonial,
+			ClientName:          val.author,
+			Role:                val.role,
+		}
+		if err := firstOrCreate(db, model.PortofolioTestimonial{PortofolioSectionID: section.ID, ClientName: val.author}, &testimonial, inserted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seedDemoTeam(db *gorm.DB, inserted *int) error {
+	members := []struct {
+		name, role, tagline string
+	}{
+		{"Dimas Hartono", "Chief Executive Officer", "Turns client goals into roadmaps"},
+		{"Sari Kusuma", "Head of Design", "Believes every pixel should earn its place"},
+		{"Yusuf Ramadhan", "Head of Engineering", "Ships on Fridays, and sleeps well"},
+		{"Maya Lestari", "Product Manager", "Keeps scope small and outcomes big"},
+	}
+
+	for i, val := range members {
+		row := model.OurTeam{
+			Name:      val.name,
+			Role:      val.role,
+			Tagline:   val.tagline,
+			PathPhoto: demoImage("400x400", val.name),
+			Position:  int64(i + 1),
+		}
+		if err := firstOrCreate(db, model.OurTeam{Name: val.name}, &row, inserted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seedDemoFaq(db *gorm.DB, inserted *int) error {
+	faqs := []struct {
+		title, description string
+	}{
+		{"How long does a typical project take?", "Most websites launch in 6 to 10 weeks and mobile apps in 3 to 5 months. We agree on milestones in the first week so you always know what ships next."},
+		{"How much does a project cost?", "We price per fixed scope or per dedicated squad each month. After a free discovery call we send a proposal with a detailed estimate."},
+		{"Do you maintain the product after launch?", "Yes. Our support plans cover monitoring, security updates and a monthly budget for improvements."},
+		{"Can we work with our own engineers?", "Absolutely. We often pair with in-house teams and hand over documentation, pipelines and knowledge at the end."},
+	}
+
+	for i, val := range faqs {
+		row := model.FaqSection{
+			Title:       val.title,
+			Description: val.description,
+			Position:    int64(i + 1),
+		}
+		if err := firstOrCreate(db, model.FaqSection{Title: val.title}, &row, inserted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seedDemoContact(db *gorm.DB, inserted *int) error {
+	companyName := "PT Arunika Digital Indonesia"
+	return firstOrCreate(db, model.ContactUs{CompanyName: companyName}, &model.ContactUs{
+		CompanyName:  companyName,
+		LocationName: "Jakarta Head Office",
+		Address:      "Jl. Jend. Sudirman Kav. 52-53, Senayan, Jakarta Selatan 12190",
+		PhoneNumber:  "+62 21 5080 1234",
+	}, inserted)
+}
@@ -0,0 +1,90 @@
+package seeds
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	SetAdmin = "admin"
+	SetDemo  = "demo"
+)
+
+// Options configures the seed sets, only the admin set reads them.
+type Options struct {
+	AdminName     string
+	AdminEmail    string
+	AdminPassword string
+}
+
+// Set is a named group of rows seeded together.
+type Set struct {
+	Name        string
+	Description string
+	// Run inserts the rows of the set missing from db and returns how many
+	// it inserted, rows already there are left untouched.
+	Run func(ctx context.Context, db *gorm.DB, opts Options) (int, error)
+}
+
+var sets = map[string]Set{
+	SetAdmin: {Name: SetAdmin, Description: "the admin user, its credentials from flags or SEED_ADMIN_* env", Run: seedAdmin},
+	SetDemo:  {Name: SetDemo, Description: "demo content for every home page section", Run: seedDemo},
+}
+
+// Sets returns every seed set sorted by name.
+func Sets() []Set {
+	list := make([]Set, 0, len(sets))
+	for _, val := range sets {
+		list = append(list, val)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Lookup returns the set named name.
+func Lookup(name string) (Set, error) {
+	set, ok := sets[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		names := make([]string, 0, len(sets))
+		for _, val := range Sets() {
+			names = append(names, val.Name)
+		}
+		return Set{}, fmt.Errorf("unknown seed set %q, the sets are %s", name, strings.Join(names, ", "))
+	}
+	return set, nil
+}
+
+// Run seeds set in one transaction, running it again inserts nothing.
+func Run(ctx context.Context, db *gorm.DB, set Set, opts Options) (int, error) {
+	var inserted int
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		inserted, err = set.Run(ctx, tx, opts)
+		return err
+	})
+	return inserted, err
+}
+
+// firstOrCreate loads into row the row matching the non zero fields of key,
+// inserting row when there is none and counting it in inserted.
+func firstOrCreate[T any](db *gorm.DB, key T, row *T, inserted *int) error {
+	var found T
+	result := db.Where(&key).Limit(1).Find(&found)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		*row = found
+		return nil
+	}
+
+	if err := db.Create(row).Error; err != nil {
+		return err
+	}
+	*inserted++
+	return nil
+}
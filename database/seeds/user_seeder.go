@@ -1,28 +1,57 @@
 package seeds
 
 import (
+	"context"
+	"errors"
 	"latihan-compro/internal/core/domain/model"
 	"latihan-compro/utils/conv"
+	"net/mail"
+	"strings"
 
-	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
-func SeedAdmin(db *gorm.DB) {
-	bytes, err := conv.HashPassword("admin123")
+const (
+	defaultAdminName  = "admin"
+	minPasswordLength = 8
+)
+
+// seedAdmin creates the admin user unless its email is taken. An existing user
+// keeps its password, so the slow hash only runs when the user is created.
+func seedAdmin(ctx context.Context, db *gorm.DB, opts Options) (int, error) {
+	email := strings.ToLower(strings.TrimSpace(opts.AdminEmail))
+	if _, err := mail.ParseAddress(email); err != nil {
+		return 0, errors.New("a valid admin email is required, set --admin-email or SEED_ADMIN_EMAIL")
+	}
+
+	var count int64
+	if err := db.Model(&model.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	if count > 0 {
+		return 0, nil
+	}
+
+	if len(opts.AdminPassword) < minPasswordLength {
+		return 0, errors.New("an admin password of at least 8 characters is required, set --admin-password or SEED_ADMIN_PASSWORD")
+	}
+	hash, err := conv.HashPassword(opts.AdminPassword)
 	if err != nil {
-		log.Fatal().Err(err).Msg(err.Error())
+		return 0, err
 	}
 
-	admin := model.User{
-		Name:     "admin",
-		Email:    "admin@mail.com",
-		Password: bytes,
+	name := strings.TrimSpace(opts.AdminName)
+	if name == "" {
+		name = defaultAdminName
 	}
 
-	if err = db.FirstOrCreate(&admin, model.User{Email: "admin@mail.com"}).Error; err != nil {
-		log.Fatal().Err(err).Msg(err.Error())
-	} else {
-		log.Info().Msg("Admin user has been seeded")
+	admin := model.User{
+		Name:     name,
+		Email:    email,
+		Password: hash,
+	}
+	if err := db.Create(&admin).Error; err != nil {
+		return 0, err
 	}
+	return 1, nil
 }
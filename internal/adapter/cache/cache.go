@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"latihan-compro/config"
+	"net/http"
+	"time"
+)
+
+const (
+	DriverMemory = "memory"
+	DriverRedis  = "redis"
+	DriverNone   = "none"
+)
+
+const (
+	defaultTTL        = 10 * time.Minute
+	defaultMaxEntries = 1000
+)
+
+var ErrCacheMiss = errors.New("cache miss")
+
+// Entry is a cached response.
+type Entry struct {
+	Status       int         `json:"status"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+	ETag         string      `json:"etag"`
+	LastModified time.Time   `json:"last_modified"`
+}
+
+type CacheInterface interface {
+	// Get returns ErrCacheMiss when key is not cached or has expired.
+	Get(ctx context.Context, key string) (*Entry, error)
+	Set(ctx context.Context, key string, entry *Entry) error
+	// Versions returns the current version of every tag. Cache keys embed the
+	// versions of the tags they are built from, so invalidating a tag orphans
+	// every entry built from it, even one being stored concurrently.
+	Versions(ctx context.Context, tags ...string) ([]int64, error)
+	Invalidate(ctx context.Context, tags ...string) error
+}
+
+// NewCache returns the driver selected by CACHE_DRIVER, memory by default.
+func NewCache(cfg *config.Config) (CacheInterface, error) {
+	ttl := cfg.Cache.TTL
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	switch cfg.Cache.Driver {
+	case "", DriverMemory:
+		maxEntries := cfg.Cache.MaxEntries
+		if maxEntries <= 0 {
+			maxEntries = defaultMaxEntries
+		}
+		return NewMemory(ttl, maxEntries), nil
+	case DriverRedis:
+		return NewRedis(cfg, ttl)
+	case DriverNone:
+		return NewNone(), nil
+	default:
+		return nil, fmt.Errorf("unknown cache driver %q", cfg.Cache.Driver)
+	}
+}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryEntry struct {
+	key       string
+	entry     *Entry
+	expiresAt time.Time
+}
+
+type memoryStruct struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]*list.Element
+	// recent orders the entries from the most to the least recently used.
+	recent   *list.List
+	versions map[string]int64
+}
+
+// Get implements CacheInterface.
+func (m *memoryStruct) Get(ctx context.Context, key string) (*Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+	val := elem.Value.(*memoryEntry)
+	if time.Now().After(val.expiresAt) {
+		m.remove(elem)
+		return nil, ErrCacheMiss
+	}
+	m.recent.MoveToFront(elem)
+	return val.entry, nil
+}
+
+// Set implements CacheInterface.
+// Once maxEntries is reached the least recently used entry is evicted. Entries
+// orphaned by an invalidation are never read again, so they go first.
+func (m *memoryStruct) Set(ctx context.Context, key string, entry *Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	val := &memoryEntry{key: key, entry: entry, expiresAt: time.Now().Add(m.ttl)}
+	if elem, ok := m.entries[key]; ok {
+		elem.Value = val
+		m.recent.MoveToFront(elem)
+		return nil
+	}
+
+	for len(m.entries) >= m.maxEntries {
+		m.remove(m.recent.Back())
+	}
+	m.entries[key] = m.recent.PushFront(val)
+	return nil
+}
+
+func (m *memoryStruct) remove(elem *list.Element) {
+	m.recent.Remove(elem)
+	delete(m.entries, elem.Value.(*memoryEntry).key)
+}
+
+// Versions implements CacheInterface.
+func (m *memoryStruct) Versions(ctx context.Context, tags ...string) ([]int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	versions := make([]int64, 0, len(tags))
+	for _, tag := range tags {
+		versions = append(versions, m.versions[tag])
+	}
+	return versions, nil
+}
+
+// Invalidate implements CacheInterface.
+func (m *memoryStruct) Invalidate(ctx context.Context, tags ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, tag := range tags {
+		m.versions[tag]++
+	}
+	return nil
+}
+
+// NewMemory returns a cache local to this process, invalidations are not
+// shared between instances, use redis when running several.
+func NewMemory(ttl time.Duration, maxEntries int) CacheInterface {
+	return &memoryStruct{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		recent:     list.New(),
+		versions:   map[string]int64{},
+	}
+}
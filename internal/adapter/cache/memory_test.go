@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestMemoryEvictsOrphanedEntriesWhenFull(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(time.Minute, 4)
+
+	key := func(page int) string {
+		versions, err := m.Versions(ctx, "faq-sections")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fmt.Sprintf("/faq-sections?page=%d:%v", page, versions)
+	}
+
+	for page := 1; page <= 4; page++ {
+		if err := m.Set(ctx, key(page), &Entry{Body: []byte("stale")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.Invalidate(ctx, "faq-sections"); err != nil {
+		t.Fatal(err)
+	}
+
+	for page := 1; page <= 4; page++ {
+		if err := m.Set(ctx, key(page), &Entry{Body: []byte("fresh")}); err != nil {
+			t.Fatal(err)
+		}
+		// Reading the first page keeps it cached while the rest are written.
+		if _, err := m.Get(ctx, key(1)); err != nil {
+			t.Fatalf("page 1 is not cached after writing page %d: %v", page, err)
+		}
+	}
+	for page := 1; page <= 4; page++ {
+		entry, err := m.Get(ctx, key(page))
+		if err != nil {
+			t.Fatalf("page %d is not cached on a full cache: %v", page, err)
+		}
+		if string(entry.Body) != "fresh" {
+			t.Errorf("page %d = %s, want the fresh entry", page, entry.Body)
+		}
+	}
+}
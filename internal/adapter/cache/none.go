@@ -0,0 +1,31 @@
+package cache
+
+import "context"
+
+type noneStruct struct{}
+
+// Get implements CacheInterface.
+func (n *noneStruct) Get(ctx context.Context, key string) (*Entry, error) {
+	return nil, ErrCacheMiss
+}
+
+// Set implements CacheInterface.
+func (n *noneStruct) Set(ctx context.Context, key string, entry *Entry) error {
+	return nil
+}
+
+// Versions implements CacheInterface.
+func (n *noneStruct) Versions(ctx context.Context, tags ...string) ([]int64, error) {
+	return make([]int64, len(tags)), nil
+}
+
+// Invalidate implements CacheInterface.
+func (n *noneStruct) Invalidate(ctx context.Context, tags ...string) error {
+	return nil
+}
+
+// NewNone returns a cache storing nothing, responses still get their ETag so
+// conditional requests are answered with 304.
+func NewNone() CacheInterface {
+	return &noneStruct{}
+}
@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"latihan-compro/config"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultRedisPrefix = "compro:cache:"
+
+type redisStruct struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+}
+
+// Get implements CacheInterface.
+func (r *redisStruct) Get(ctx context.Context, key string) (*Entry, error) {
+	value, err := r.client.Get(ctx, r.prefix+"response:"+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrCacheMiss
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error getting cache entry")
+		return nil, err
+	}
+
+	entry := Entry{}
+	if err = json.Unmarshal(value, &entry); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error decoding cache entry")
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// Set implements CacheInterface.
+func (r *redisStruct) Set(ctx context.Context, key string, entry *Entry) error {
+	value, err := json.Marshal(entry)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error encoding cache entry")
+		return err
+	}
+
+	if err = r.client.Set(ctx, r.prefix+"response:"+key, value, r.ttl).Err(); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error setting cache entry")
+		return err
+	}
+	return nil
+}
+
+// Versions implements CacheInterface.
+func (r *redisStruct) Versions(ctx context.Context, tags ...string) ([]int64, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		keys = append(keys, r.prefix+"tag:"+tag)
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error getting cache tag versions")
+		return nil, err
+	}
+
+	versions := make([]int64, 0, len(values))
+	for _, val := range values {
+		// Tags never invalidated have no key yet.
+		str, _ := val.(string)
+		version, _ := strconv.ParseInt(str, 10, 64)
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// Invalidate implements CacheInterface.
+func (r *redisStruct) Invalidate(ctx context.Context, tags ...string) error {
+	pipe := r.client.Pipeline()
+	for _, tag := range tags {
+		pipe.Incr(ctx, r.prefix+"tag:"+tag)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error invalidating cache tags")
+		return err
+	}
+	return nil
+}
+
+// NewRedis connects to any Redis protocol compatible server, entries and tag
+// versions are shared by every instance using the same prefix.
+func NewRedis(cfg *config.Config, ttl time.Duration) (CacheInterface, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Cache.RedisAddr,
+		Password: cfg.Cache.RedisPassword,
+		DB:       cfg.Cache.RedisDB,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error connecting to redis")
+		return nil, err
+	}
+
+	prefix := cfg.Cache.RedisPrefix
+	if prefix == "" {
+		prefix = defaultRedisPrefix
+	}
+
+	return &redisStruct{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}, nil
+}
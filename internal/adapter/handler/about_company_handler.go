@@ -8,10 +8,11 @@ import (
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type AboutCompanyHandlerInterface interface {
@@ -32,16 +33,13 @@ func (cs *aboutCompanyHandler) FetchAllCompanyHome(c echo.Context) error {
 	var (
 		respCompany = response.AboutCompanyResponse{}
 		resp        = response.DefaultSuccessResponse{}
-		respError   = response.ErrorResponseDefault{}
 		ctx         = c.Request().Context()
 	)
 
 	result, err := cs.aboutCompanyService.FetchAllCompanyAndKeynote(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllCompanyHome - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllCompanyHome - 1")
+		return err
 	}
 
 	respCompany.ID = result.ID
@@ -52,6 +50,7 @@ func (cs *aboutCompanyHandler) FetchAllCompanyHome(c echo.Context) error {
 			AboutCompanyID: val.AboutCompanyID,
 			Keynote:        val.Keynote,
 			PathImage:      val.PathImage,
+			ImageVariants:  upload.ImageVariants(val.PathImage),
 		})
 	}
 	resp.Meta.Message = "Success fetch all company home"
@@ -65,32 +64,25 @@ func (cs *aboutCompanyHandler) FetchAllCompanyHome(c echo.Context) error {
 // CreateAboutCompany implements AboutCompanyHandlerInterface.
 func (cs *aboutCompanyHandler) CreateAboutCompany(c echo.Context) error {
 	var (
-		req       = request.AboutCompanyRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.AboutCompanyRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreateAboutCompany - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateAboutCompany - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	if err := c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateAboutCompany - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAboutCompany - 2")
+		return bindError(err)
 	}
 
 	if err := c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateAboutCompany - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAboutCompany - 3")
+		return err
 	}
 
 	reqEntity := entity.AboutCompanyEntity{
@@ -99,10 +91,8 @@ func (cs *aboutCompanyHandler) CreateAboutCompany(c echo.Context) error {
 
 	err := cs.aboutCompanyService.CreateAboutCompany(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateAboutCompany - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAboutCompany - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create about company"
@@ -115,34 +105,27 @@ func (cs *aboutCompanyHandler) CreateAboutCompany(c echo.Context) error {
 // DeleteByIDAboutCompany implements AboutCompanyHandlerInterface.
 func (cs *aboutCompanyHandler) DeleteByIDAboutCompany(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDAboutCompany - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDAboutCompany - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idAboutCompany := c.Param("id")
 	id, err := conv.StringToInt64(idAboutCompany)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDAboutCompany - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDAboutCompany - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.aboutCompanyService.DeleteByIDAboutCompany(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDAboutCompany - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDAboutCompany - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete about company"
 	resp.Meta.Status = true
@@ -155,41 +138,32 @@ func (cs *aboutCompanyHandler) DeleteByIDAboutCompany(c echo.Context) error {
 // EditByIDAboutCompany implements AboutCompanyHandlerInterface.
 func (cs *aboutCompanyHandler) EditByIDAboutCompany(c echo.Context) error {
 	var (
-		req       = request.AboutCompanyRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.AboutCompanyRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDAboutCompany - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDAboutCompany - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idAboutCompany := c.Param("id")
 	id, err := conv.StringToInt64(idAboutCompany)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDAboutCompany - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDAboutCompany - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err := c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDAboutCompany - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDAboutCompany - 3")
+		return bindError(err)
 	}
 
 	if err := c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDAboutCompany - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDAboutCompany - 4")
+		return err
 	}
 
 	reqEntity := entity.AboutCompanyEntity{
@@ -199,10 +173,8 @@ func (cs *aboutCompanyHandler) EditByIDAboutCompany(c echo.Context) error {
 
 	err = cs.aboutCompanyService.EditByIDAboutCompany(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDAboutCompany - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDAboutCompany - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit about company"
 	resp.Meta.Status = true
@@ -215,25 +187,20 @@ func (cs *aboutCompanyHandler) EditByIDAboutCompany(c echo.Context) error {
 func (cs *aboutCompanyHandler) FetchAllAboutCompany(c echo.Context) error {
 	var (
 		resp             = response.DefaultSuccessResponse{}
-		respError        = response.ErrorResponseDefault{}
 		ctx              = c.Request().Context()
 		respAboutCompany = []response.AboutCompanyResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllAboutCompany - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllAboutCompany - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.aboutCompanyService.FetchAllAboutCompany(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllAboutCompany - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllAboutCompany - 2")
+		return err
 	}
 
 	for _, val := range results {
@@ -254,34 +221,27 @@ func (cs *aboutCompanyHandler) FetchAllAboutCompany(c echo.Context) error {
 func (cs *aboutCompanyHandler) FetchByIDAboutCompany(c echo.Context) error {
 	var (
 		resp             = response.DefaultSuccessResponse{}
-		respError        = response.ErrorResponseDefault{}
 		ctx              = c.Request().Context()
 		respAboutCompany = response.AboutCompanyResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDAboutCompany - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDAboutCompany - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idAboutCompany := c.Param("id")
 	id, err := conv.StringToInt64(idAboutCompany)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDAboutCompany - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDAboutCompany - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.aboutCompanyService.FetchByIDAboutCompany(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDAboutCompany - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDAboutCompany - 3")
+		return err
 	}
 
 	respAboutCompany.ID = result.ID
@@ -301,7 +261,7 @@ func NewAboutCompanyHandler(e *echo.Echo, aboutCompanyService service.AboutCompa
 	mid := middleware.NewMiddleware(cfg)
 
 	aboutCompanyApp := e.Group("/about-company")
-	aboutCompanyApp.GET("", h.FetchAllCompanyHome)
+	aboutCompanyApp.GET("", h.FetchAllCompanyHome, mid.Locale())
 
 	adminApp := aboutCompanyApp.Group("/admin", mid.CheckToken())
 
@@ -8,10 +8,11 @@ import (
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type AboutCompanyKeynoteHandlerInterface interface {
@@ -31,34 +32,27 @@ type aboutCompanyKeynoteHandler struct {
 func (cs *aboutCompanyKeynoteHandler) FetchByCompanyID(c echo.Context) error {
 	var (
 		resp                    = response.DefaultSuccessResponse{}
-		respError               = response.ErrorResponseDefault{}
 		ctx                     = c.Request().Context()
 		respAboutCompanyKeynote = []response.AboutCompanyKeynoteResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByCompanyID - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByCompanyID - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idAboutCompany := c.Param("id")
 	id, err := conv.StringToInt64(idAboutCompany)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByCompanyID - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByCompanyID - 2")
+		return conv.ErrBadParamInput
 	}
 
 	results, err := cs.aboutCompanyKeynoteService.FetchByCompanyID(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByCompanyID - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByCompanyID - 3")
+		return err
 	}
 
 	for _, val := range results {
@@ -67,6 +61,7 @@ func (cs *aboutCompanyKeynoteHandler) FetchByCompanyID(c echo.Context) error {
 			AboutCompanyID:          val.AboutCompanyID,
 			Keynote:                 val.Keynote,
 			PathImage:               val.PathImage,
+			ImageVariants:           upload.ImageVariants(val.PathImage),
 			AboutCompanyDescription: val.AboutCompanyDescription,
 		})
 	}
@@ -81,32 +76,25 @@ func (cs *aboutCompanyKeynoteHandler) FetchByCompanyID(c echo.Context) error {
 // CreateAboutCompanyKeynote implements AboutCompanyKeynoteHandlerInterface.
 func (cs *aboutCompanyKeynoteHandler) CreateAboutCompanyKeynote(c echo.Context) error {
 	var (
-		req       = request.AboutCompanyKeynoteRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.AboutCompanyKeynoteRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreateAboutCompanyKeynote - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateAboutCompanyKeynote - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateAboutCompanyKeynote - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAboutCompanyKeynote - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateAboutCompanyKeynote - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAboutCompanyKeynote - 3")
+		return err
 	}
 
 	reqEntity := entity.AboutCompanyKeynoteEntity{
@@ -115,12 +103,10 @@ func (cs *aboutCompanyKeynoteHandler) CreateAboutCompanyKeynote(c echo.Context)
 		PathImage:      req.PathImage,
 	}
 
-	err = cs.aboutCompanyKeynoteService.CreateAboutCompanyKeynote(ctx, reqEntity)
+	err := cs.aboutCompanyKeynoteService.CreateAboutCompanyKeynote(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateAboutCompanyKeynote - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAboutCompanyKeynote - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create about company keynote"
@@ -133,34 +119,27 @@ func (cs *aboutCompanyKeynoteHandler) CreateAboutCompanyKeynote(c echo.Context)
 // DeleteByIDAboutCompanyKeynote implements AboutCompanyKeynoteHandlerInterface.
 func (cs *aboutCompanyKeynoteHandler) DeleteByIDAboutCompanyKeynote(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDAboutCompanyKeynote - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDAboutCompanyKeynote - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idAboutCompanyKeynote := c.Param("id")
 	id, err := conv.StringToInt64(idAboutCompanyKeynote)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDAboutCompanyKeynote - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDAboutCompanyKeynote - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.aboutCompanyKeynoteService.DeleteByIDAboutCompanyKeynote(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDAboutCompanyKeynote - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDAboutCompanyKeynote - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete about company keynote"
 	resp.Meta.Status = true
@@ -173,41 +152,32 @@ func (cs *aboutCompanyKeynoteHandler) DeleteByIDAboutCompanyKeynote(c echo.Conte
 // EditByIDAboutCompanyKeynote implements AboutCompanyKeynoteHandlerInterface.
 func (cs *aboutCompanyKeynoteHandler) EditByIDAboutCompanyKeynote(c echo.Context) error {
 	var (
-		req       = request.AboutCompanyKeynoteRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.AboutCompanyKeynoteRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDAboutCompanyKeynote - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDAboutCompanyKeynote - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idAboutCompanyKeynote := c.Param("id")
 	id, err := conv.StringToInt64(idAboutCompanyKeynote)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDAboutCompanyKeynote - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDAboutCompanyKeynote - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDAboutCompanyKeynote - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDAboutCompanyKeynote - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDAboutCompanyKeynote - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDAboutCompanyKeynote - 4")
+		return err
 	}
 
 	reqEntity := entity.AboutCompanyKeynoteEntity{
@@ -219,10 +189,8 @@ func (cs *aboutCompanyKeynoteHandler) EditByIDAboutCompanyKeynote(c echo.Context
 
 	err = cs.aboutCompanyKeynoteService.EditByIDAboutCompanyKeynote(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDAboutCompanyKeynote - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDAboutCompanyKeynote - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit about company keynote"
 	resp.Meta.Status = true
@@ -235,25 +203,20 @@ func (cs *aboutCompanyKeynoteHandler) EditByIDAboutCompanyKeynote(c echo.Context
 func (cs *aboutCompanyKeynoteHandler) FetchAllAboutCompanyKeynote(c echo.Context) error {
 	var (
 		resp                    = response.DefaultSuccessResponse{}
-		respError               = response.ErrorResponseDefault{}
 		ctx                     = c.Request().Context()
 		respAboutCompanyKeynote = []response.AboutCompanyKeynoteResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllAboutCompanyKeynote - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllAboutCompanyKeynote - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.aboutCompanyKeynoteService.FetchAllAboutCompanyKeynote(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllAboutCompanyKeynote - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllAboutCompanyKeynote - 2")
+		return err
 	}
 
 	for _, val := range results {
@@ -262,6 +225,7 @@ func (cs *aboutCompanyKeynoteHandler) FetchAllAboutCompanyKeynote(c echo.Context
 			AboutCompanyID:          val.AboutCompanyID,
 			Keynote:                 val.Keynote,
 			PathImage:               val.PathImage,
+			ImageVariants:           upload.ImageVariants(val.PathImage),
 			AboutCompanyDescription: val.AboutCompanyDescription,
 		})
 	}
@@ -277,40 +241,34 @@ func (cs *aboutCompanyKeynoteHandler) FetchAllAboutCompanyKeynote(c echo.Context
 func (cs *aboutCompanyKeynoteHandler) FetchByIDAboutCompanyKeynote(c echo.Context) error {
 	var (
 		resp                    = response.DefaultSuccessResponse{}
-		respError               = response.ErrorResponseDefault{}
 		ctx                     = c.Request().Context()
 		respAboutCompanyKeynote = response.AboutCompanyKeynoteResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDAboutCompanyKeynote - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDAboutCompanyKeynote - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idAboutCompanyKeynote := c.Param("id")
 	id, err := conv.StringToInt64(idAboutCompanyKeynote)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDAboutCompanyKeynote - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDAboutCompanyKeynote - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.aboutCompanyKeynoteService.FetchByIDAboutCompanyKeynote(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDAboutCompanyKeynote - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDAboutCompanyKeynote - 3")
+		return err
 	}
 
 	respAboutCompanyKeynote.ID = result.ID
 	respAboutCompanyKeynote.AboutCompanyID = result.AboutCompanyID
 	respAboutCompanyKeynote.Keynote = result.Keynote
 	respAboutCompanyKeynote.PathImage = result.PathImage
+	respAboutCompanyKeynote.ImageVariants = upload.ImageVariants(result.PathImage)
 	respAboutCompanyKeynote.AboutCompanyDescription = result.AboutCompanyDescription
 	resp.Meta.Message = "Success fetch about company keynote by ID"
 	resp.Meta.Status = true
@@ -5,6 +5,7 @@ import (
 	"latihan-compro/internal/adapter/handler/request"
 	"latihan-compro/internal/adapter/handler/response"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
@@ -12,7 +13,7 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type AppointmentHandlerInterface interface {
@@ -28,32 +29,25 @@ type appointmentHandler struct {
 // CreateAppointment implements AppointmentHandlerInterface.
 func (cs *appointmentHandler) CreateAppointment(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		req       = request.AppointmentRequest{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		req  = request.AppointmentRequest{}
+		ctx  = c.Request().Context()
 	)
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateAppointment - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAppointment - 1")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateAppointment - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAppointment - 2")
+		return err
 	}
 
 	stringProjectDate, err := time.Parse("2006-01-02", req.MeetAt)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateAppointment - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAppointment - 3")
+		return errs.Wrap(err, errs.KindValidation, "invalid_date", "meet_at must be a date (YYYY-MM-DD)")
 	}
 
 	reqEntity := entity.AppointmentEntity{
@@ -68,10 +62,8 @@ func (cs *appointmentHandler) CreateAppointment(c echo.Context) error {
 
 	err = cs.appointmentService.CreateAppointment(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateAppointment - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateAppointment - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create appointment"
@@ -85,25 +77,20 @@ func (cs *appointmentHandler) CreateAppointment(c echo.Context) error {
 func (cs *appointmentHandler) FetchAllAppointment(c echo.Context) error {
 	var (
 		resp            = response.DefaultSuccessResponse{}
-		respError       = response.ErrorResponseDefault{}
 		ctx             = c.Request().Context()
 		respAppointment = []response.AppointmentResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllAppointment - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllAppointment - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.appointmentService.FetchAllAppointment(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllAppointment - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllAppointment - 2")
+		return err
 	}
 
 	for _, val := range results {
@@ -131,34 +118,27 @@ func (cs *appointmentHandler) FetchAllAppointment(c echo.Context) error {
 func (cs *appointmentHandler) FetchByIDAppointment(c echo.Context) error {
 	var (
 		resp            = response.DefaultSuccessResponse{}
-		respError       = response.ErrorResponseDefault{}
 		ctx             = c.Request().Context()
 		respAppointment = response.AppointmentResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDAppointment - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDAppointment - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idAppointment := c.Param("id")
 	id, err := conv.StringToInt64(idAppointment)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDAppointment - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDAppointment - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.appointmentService.FetchByIDAppointment(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDAppointment - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDAppointment - 3")
+		return err
 	}
 
 	respAppointment.ID = result.ID
@@ -180,34 +160,27 @@ func (cs *appointmentHandler) FetchByIDAppointment(c echo.Context) error {
 // DeleteByIDAppointment implements AppointmentHandlerInterface.
 func (cs *appointmentHandler) DeleteByIDAppointment(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDAppointment - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDAppointment - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idAppointment := c.Param("id")
 	id, err := conv.StringToInt64(idAppointment)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDAppointment - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDAppointment - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.appointmentService.DeleteByIDAppointment(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDAppointment - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDAppointment - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete appointment"
 	resp.Meta.Status = true
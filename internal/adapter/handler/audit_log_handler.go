@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"encoding/json"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/middleware"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type AuditLogHandlerInterface interface {
+	FetchAllAuditLog(c echo.Context) error
+}
+
+type auditLogHandler struct {
+	auditLogService service.AuditLogServiceInterface
+}
+
+// FetchAllAuditLog implements AuditLogHandlerInterface.
+func (cs *auditLogHandler) FetchAllAuditLog(c echo.Context) error {
+	var (
+		resp          = response.DefaultSuccessResponse{}
+		ctx           = c.Request().Context()
+		respAuditLogs = []response.AuditLogResponse{}
+		filter        = entity.AuditLogFilterEntity{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllAuditLog - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	filter.Action = c.QueryParam("action")
+	filter.EntityType = c.QueryParam("entity_type")
+	filter.Page, _ = strconv.Atoi(c.QueryParam("page"))
+	filter.PerPage, _ = strconv.Atoi(c.QueryParam("per_page"))
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PerPage < 1 || filter.PerPage > 100 {
+		filter.PerPage = 20
+	}
+
+	for name, target := range map[string]*int64{"actor_id": &filter.ActorID, "entity_id": &filter.EntityID} {
+		if c.QueryParam(name) == "" {
+			continue
+		}
+		value, err := conv.StringToInt64(c.QueryParam(name))
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllAuditLog - 2")
+			return errs.Wrap(err, errs.KindValidation, "invalid_query_param", "query param "+name+" must be a number")
+		}
+		*target = value
+	}
+
+	for name, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		if c.QueryParam(name) == "" {
+			continue
+		}
+		value, err := parseAuditLogDate(c.QueryParam(name), name == "to")
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllAuditLog - 3")
+			return errs.Wrap(err, errs.KindValidation, "invalid_query_param", "query param "+name+" must be a date (YYYY-MM-DD) or RFC3339 time")
+		}
+		*target = &value
+	}
+
+	results, total, err := cs.auditLogService.FetchAllAuditLog(ctx, filter)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllAuditLog - 4")
+		return err
+	}
+
+	for _, val := range results {
+		respAuditLog := response.AuditLogResponse{
+			ID:         val.ID,
+			ActorID:    val.ActorID,
+			Action:     val.Action,
+			EntityType: val.EntityType,
+			EntityID:   val.EntityID,
+			IpAddress:  val.IpAddress,
+			UserAgent:  val.UserAgent,
+			CreatedAt:  val.CreatedAt,
+		}
+		if val.BeforeValue != nil {
+			respAuditLog.BeforeValue = json.RawMessage(*val.BeforeValue)
+		}
+		if val.AfterValue != nil {
+			respAuditLog.AfterValue = json.RawMessage(*val.AfterValue)
+		}
+		respAuditLogs = append(respAuditLogs, respAuditLog)
+	}
+
+	resp.Meta.Message = "Success fetch all audit log"
+	resp.Meta.Status = true
+	resp.Data = respAuditLogs
+	resp.Pagination = &response.PaginationResponse{
+		TotalRecords: int(total),
+		Page:         filter.Page,
+		PerPage:      filter.PerPage,
+		TotalPages:   (int(total) + filter.PerPage - 1) / filter.PerPage,
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// parseAuditLogDate accepts a plain date or a full RFC3339 timestamp. A plain
+// date used as upper bound covers the whole day.
+func parseAuditLogDate(value string, upperBound bool) (time.Time, error) {
+	if date, err := time.Parse("2006-01-02", value); err == nil {
+		if upperBound {
+			date = date.AddDate(0, 0, 1)
+		}
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func NewAuditLogHandler(e *echo.Echo, auditLogService service.AuditLogServiceInterface, cfg *config.Config) AuditLogHandlerInterface {
+	h := &auditLogHandler{
+		auditLogService: auditLogService,
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	auditLogApp := e.Group("/audit-logs")
+	adminApp := auditLogApp.Group("/admin", mid.CheckToken())
+
+	adminApp.GET("", h.FetchAllAuditLog)
+
+	return h
+}
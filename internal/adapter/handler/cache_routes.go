@@ -0,0 +1,39 @@
+package handler
+
+import "latihan-compro/internal/core/domain/entity"
+
+// CachedRoutes maps every cached public route to the content types its
+// response is built from, editing any of them invalidates the route.
+// Service detail documents are left out, their signed urls expire.
+var CachedRoutes = map[string][]string{
+	"/hero-sections":    {entity.ContentTypeHeroSection},
+	"/client-sections":  {entity.ContentTypeClientSection},
+	"/about-company":    {entity.ContentTypeAboutCompany, entity.ContentTypeAboutCompanyKeynote},
+	"/service-sections": {entity.ContentTypeServiceSection},
+	"/portofolio-sections": {
+		entity.ContentTypePortofolioSection,
+	},
+	"/portofolio-details/:id": {
+		entity.ContentTypePortofolioDetail,
+		entity.ContentTypePortofolioSection,
+	},
+	"/portofolio-testimonials": {
+		entity.ContentTypePortofolioTestimonial,
+		entity.ContentTypePortofolioSection,
+	},
+	"/our-teams":    {entity.ContentTypeOurTeam},
+	"/faq-sections": {entity.ContentTypeFaqSection},
+	"/contact-us":   {entity.ContentTypeContactUs},
+	"/site": {
+		entity.ContentTypeHeroSection,
+		entity.ContentTypeClientSection,
+		entity.ContentTypeAboutCompany,
+		entity.ContentTypeAboutCompanyKeynote,
+		entity.ContentTypeServiceSection,
+		entity.ContentTypePortofolioSection,
+		entity.ContentTypePortofolioTestimonial,
+		entity.ContentTypeOurTeam,
+		entity.ContentTypeFaqSection,
+		entity.ContentTypeContactUs,
+	},
+}
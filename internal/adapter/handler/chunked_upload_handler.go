@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"encoding/base64"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/middleware"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// The upload protocol follows tus 1.0.0 (https://tus.io/protocols/resumable-upload)
+// with the creation and termination extensions, so any tus client works.
+const (
+	tusVersion    = "1.0.0"
+	tusExtensions = "creation,termination"
+
+	headerTusResumable   = "Tus-Resumable"
+	headerTusVersion     = "Tus-Version"
+	headerTusExtension   = "Tus-Extension"
+	headerTusMaxSize     = "Tus-Max-Size"
+	headerUploadLength   = "Upload-Length"
+	headerUploadOffset   = "Upload-Offset"
+	headerUploadMetadata = "Upload-Metadata"
+
+	mimeOffsetOctetStream = "application/offset+octet-stream"
+)
+
+// TusHeaders are the response headers browsers must be allowed to read.
+var TusHeaders = []string{
+	echo.HeaderLocation, headerTusResumable, headerTusVersion, headerTusExtension,
+	headerTusMaxSize, headerUploadLength, headerUploadOffset, headerUploadMetadata,
+}
+
+type ChunkedUploadHandlerInterface interface {
+	OptionsChunkedUpload(c echo.Context) error
+	CreateChunkedUpload(c echo.Context) error
+	HeadChunkedUpload(c echo.Context) error
+	FetchByIDChunkedUpload(c echo.Context) error
+	AppendChunkedUpload(c echo.Context) error
+	DeleteByIDChunkedUpload(c echo.Context) error
+}
+
+type chunkedUploadHandler struct {
+	chunkedUploadService service.ChunkedUploadServiceInterface
+}
+
+// OptionsChunkedUpload implements ChunkedUploadHandlerInterface.
+func (ch *chunkedUploadHandler) OptionsChunkedUpload(c echo.Context) error {
+	c.Response().Header().Set(headerTusVersion, tusVersion)
+	c.Response().Header().Set(headerTusExtension, tusExtensions)
+	c.Response().Header().Set(headerTusMaxSize, strconv.FormatInt(ch.chunkedUploadService.MaxSize(), 10))
+	return c.NoContent(http.StatusNoContent)
+}
+
+// CreateChunkedUpload implements ChunkedUploadHandlerInterface.
+func (ch *chunkedUploadHandler) CreateChunkedUpload(c echo.Context) error {
+	var (
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateChunkedUpload - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	length, err := strconv.ParseInt(c.Request().Header.Get(headerUploadLength), 10, 64)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateChunkedUpload - 2")
+		return errs.Wrap(err, errs.KindValidation, "invalid_header", "invalid "+headerUploadLength+" header")
+	}
+
+	metadata := parseUploadMetadata(c.Request().Header.Get(headerUploadMetadata))
+	result, err := ch.chunkedUploadService.CreateChunkedUpload(ctx, length, metadata["filename"])
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateChunkedUpload - 3")
+		return uploadError(err)
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, c.Request().URL.Path+"/"+result.ID)
+	c.Response().Header().Set(headerUploadOffset, "0")
+	resp.Meta.Message = "Success create upload"
+	resp.Meta.Status = true
+	resp.Data = toChunkedUploadResponse(*result)
+	resp.Pagination = nil
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// HeadChunkedUpload implements ChunkedUploadHandlerInterface.
+// It tells a client where to resume, without a body as HEAD requires.
+func (ch *chunkedUploadHandler) HeadChunkedUpload(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] HeadChunkedUpload - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	result, err := ch.chunkedUploadService.FetchByIDChunkedUpload(ctx, c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] HeadChunkedUpload - 2")
+		return uploadError(err)
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set(headerUploadOffset, strconv.FormatInt(result.Offset, 10))
+	c.Response().Header().Set(headerUploadLength, strconv.FormatInt(result.Length, 10))
+	return c.NoContent(http.StatusOK)
+}
+
+// FetchByIDChunkedUpload implements ChunkedUploadHandlerInterface.
+func (ch *chunkedUploadHandler) FetchByIDChunkedUpload(c echo.Context) error {
+	var (
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDChunkedUpload - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	result, err := ch.chunkedUploadService.FetchByIDChunkedUpload(ctx, c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDChunkedUpload - 2")
+		return uploadError(err)
+	}
+
+	resp.Meta.Message = "Success fetch upload by ID"
+	resp.Meta.Status = true
+	resp.Data = toChunkedUploadResponse(*result)
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// AppendChunkedUpload implements ChunkedUploadHandlerInterface.
+// The request body is handed to the service as it arrives, never buffered.
+func (ch *chunkedUploadHandler) AppendChunkedUpload(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] AppendChunkedUpload - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	if c.Request().Header.Get(echo.HeaderContentType) != mimeOffsetOctetStream {
+		log.Ctx(ctx).Error().Msg("[HANDLER] AppendChunkedUpload - 2: invalid content type")
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, "content type must be "+mimeOffsetOctetStream)
+	}
+
+	offset, err := strconv.ParseInt(c.Request().Header.Get(headerUploadOffset), 10, 64)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] AppendChunkedUpload - 3")
+		return errs.Wrap(err, errs.KindValidation, "invalid_header", "invalid "+headerUploadOffset+" header")
+	}
+
+	result, err := ch.chunkedUploadService.AppendChunkedUpload(ctx, c.Param("id"), offset, c.Request().Body, c.Request().ContentLength)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] AppendChunkedUpload - 4")
+		return uploadError(err)
+	}
+
+	c.Response().Header().Set(headerUploadOffset, strconv.FormatInt(result.Offset, 10))
+	return c.NoContent(http.StatusNoContent)
+}
+
+// DeleteByIDChunkedUpload implements ChunkedUploadHandlerInterface.
+func (ch *chunkedUploadHandler) DeleteByIDChunkedUpload(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDChunkedUpload - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	err := ch.chunkedUploadService.DeleteByIDChunkedUpload(ctx, c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDChunkedUpload - 2")
+		return uploadError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// tusResumable rejects clients speaking another protocol version and marks
+// every response with the version in use.
+func tusResumable(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Response().Header().Set(headerTusResumable, tusVersion)
+
+		version := c.Request().Header.Get(headerTusResumable)
+		if c.Request().Method != http.MethodOptions && version != "" && version != tusVersion {
+			c.Response().Header().Set(headerTusVersion, tusVersion)
+			return c.NoContent(http.StatusPreconditionFailed)
+		}
+		return next(c)
+	}
+}
+
+// parseUploadMetadata decodes "key base64value,key base64value" pairs.
+func parseUploadMetadata(value string) map[string]string {
+	metadata := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			continue
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+		metadata[key] = string(decoded)
+	}
+	return metadata
+}
+
+func toChunkedUploadResponse(val entity.ChunkedUploadEntity) response.ChunkedUploadResponse {
+	return response.ChunkedUploadResponse{
+		ID:           val.ID,
+		FileName:     val.FileName,
+		Length:       val.Length,
+		Offset:       val.Offset,
+		ContentType:  val.ContentType,
+		Completed:    val.CompletedAt != nil,
+		MediaAssetID: val.MediaAssetID,
+		Url:          val.Url,
+		CreatedAt:    val.CreatedAt,
+	}
+}
+
+func NewChunkedUploadHandler(e *echo.Echo, chunkedUploadService service.ChunkedUploadServiceInterface, cfg *config.Config) ChunkedUploadHandlerInterface {
+	h := &chunkedUploadHandler{
+		chunkedUploadService: chunkedUploadService,
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	uploadVideoApp := e.Group("/upload-video", tusResumable)
+	uploadVideoApp.OPTIONS("", h.OptionsChunkedUpload)
+	uploadVideoApp.POST("", h.CreateChunkedUpload, mid.CheckToken())
+	uploadVideoApp.HEAD("/:id", h.HeadChunkedUpload, mid.CheckToken())
+	uploadVideoApp.GET("/:id", h.FetchByIDChunkedUpload, mid.CheckToken())
+	uploadVideoApp.PATCH("/:id", h.AppendChunkedUpload, mid.CheckToken())
+	uploadVideoApp.DELETE("/:id", h.DeleteByIDChunkedUpload, mid.CheckToken())
+
+	return h
+}
@@ -8,10 +8,11 @@ import (
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type ClientSectionHandlerInterface interface {
@@ -30,32 +31,25 @@ type clientSectionHandler struct {
 // CreateClientSection implements ClientSectionHandlerInterface.
 func (cs *clientSectionHandler) CreateClientSection(c echo.Context) error {
 	var (
-		req       = request.ClientSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.ClientSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreateClientSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateClientSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateClientSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateClientSection - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateClientSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateClientSection - 3")
+		return err
 	}
 
 	reqEntity := entity.ClientSectionEntity{
@@ -63,12 +57,10 @@ func (cs *clientSectionHandler) CreateClientSection(c echo.Context) error {
 		PathIcon: req.PathIcon,
 	}
 
-	err = cs.clientSectionService.CreateClientSection(ctx, reqEntity)
+	err := cs.clientSectionService.CreateClientSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateClientSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateClientSection - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create client section"
@@ -82,32 +74,29 @@ func (cs *clientSectionHandler) CreateClientSection(c echo.Context) error {
 func (cs *clientSectionHandler) FetchAllClientSection(c echo.Context) error {
 	var (
 		resp       = response.DefaultSuccessResponse{}
-		respError  = response.ErrorResponseDefault{}
 		ctx        = c.Request().Context()
 		respClient = []response.ClientSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllClientSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllClientSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.clientSectionService.FetchAllClientSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllClientSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllClientSection - 2")
+		return err
 	}
 
 	for _, val := range results {
 		respClient = append(respClient, response.ClientSectionResponse{
-			ID:       val.ID,
-			Name:     val.Name,
-			PathIcon: val.PathIcon,
+			ID:           val.ID,
+			Position:     val.Position,
+			Name:         val.Name,
+			PathIcon:     val.PathIcon,
+			IconVariants: upload.ImageVariants(val.PathIcon),
 		})
 	}
 
@@ -122,39 +111,34 @@ func (cs *clientSectionHandler) FetchAllClientSection(c echo.Context) error {
 func (cs *clientSectionHandler) FetchByIDClientSection(c echo.Context) error {
 	var (
 		resp       = response.DefaultSuccessResponse{}
-		respError  = response.ErrorResponseDefault{}
 		ctx        = c.Request().Context()
 		respClient = response.ClientSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDClientSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDClientSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idClient := c.Param("id")
 	id, err := conv.StringToInt64(idClient)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDClientSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDClientSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.clientSectionService.FetchByIDClientSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDClientSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDClientSection - 3")
+		return err
 	}
 
 	respClient.ID = result.ID
+	respClient.Position = result.Position
 	respClient.Name = result.Name
 	respClient.PathIcon = result.PathIcon
+	respClient.IconVariants = upload.ImageVariants(result.PathIcon)
 	resp.Meta.Message = "Success fetch hero section by ID"
 	resp.Meta.Status = true
 	resp.Data = respClient
@@ -165,41 +149,32 @@ func (cs *clientSectionHandler) FetchByIDClientSection(c echo.Context) error {
 // EditByIDClientSection implements ClientSectionHandlerInterface.
 func (cs *clientSectionHandler) EditByIDClientSection(c echo.Context) error {
 	var (
-		req       = request.ClientSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.ClientSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDClientSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDClientSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idClient := c.Param("id")
 	id, err := conv.StringToInt64(idClient)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDClientSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDClientSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDClientSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDClientSection - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDClientSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDClientSection - 4")
+		return err
 	}
 
 	reqEntity := entity.ClientSectionEntity{
@@ -210,10 +185,8 @@ func (cs *clientSectionHandler) EditByIDClientSection(c echo.Context) error {
 
 	err = cs.clientSectionService.EditByIDClientSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDClientSection - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDClientSection - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit client section"
 	resp.Meta.Status = true
@@ -225,34 +198,27 @@ func (cs *clientSectionHandler) EditByIDClientSection(c echo.Context) error {
 // DeleteByIDClientSection implements ClientSectionHandlerInterface.
 func (cs *clientSectionHandler) DeleteByIDClientSection(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDClientSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDClientSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idClient := c.Param("id")
 	id, err := conv.StringToInt64(idClient)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDClientSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDClientSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.clientSectionService.DeleteByIDClientSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDClientSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDClientSection - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete client section"
 	resp.Meta.Status = true
@@ -267,23 +233,22 @@ func (cs *clientSectionHandler) FetchAllClientSectionHome(c echo.Context) error
 	var (
 		respClients = []response.ClientSectionResponse{}
 		resp        = response.DefaultSuccessResponse{}
-		respError   = response.ErrorResponseDefault{}
 		ctx         = c.Request().Context()
 	)
 
 	results, err := cs.clientSectionService.FetchAllClientSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllClientSectionHome - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllClientSectionHome - 1")
+		return err
 	}
 
 	for _, val := range results {
 		respClients = append(respClients, response.ClientSectionResponse{
-			ID:       val.ID,
-			Name:     val.Name,
-			PathIcon: val.PathIcon,
+			ID:           val.ID,
+			Position:     val.Position,
+			Name:         val.Name,
+			PathIcon:     val.PathIcon,
+			IconVariants: upload.ImageVariants(val.PathIcon),
 		})
 	}
 
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/cache"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/auth"
+	"latihan-compro/utils/validator"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// memoryFaqSectionRepository keeps faq sections in a map, every failure names
+// the request it belongs to so a leaked error shows up in another response.
+type memoryFaqSectionRepository struct {
+	mu     sync.Mutex
+	nextID int64
+	faqs   map[int64]entity.FaqSectionEntity
+}
+
+func (m *memoryFaqSectionRepository) CreateFaqSection(ctx context.Context, req entity.FaqSectionEntity) error {
+	if strings.HasPrefix(req.Title, "fail") {
+		return errs.Conflict("faq_section_already_exists", fmt.Sprintf("faq section %s already exists", req.Title))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	req.ID = m.nextID
+	m.faqs[req.ID] = req
+	return nil
+}
+
+func (m *memoryFaqSectionRepository) FetchAllFaqSection(ctx context.Context) ([]entity.FaqSectionEntity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var faqs []entity.FaqSectionEntity
+	for _, val := range m.faqs {
+		faqs = append(faqs, val)
+	}
+	return faqs, nil
+}
+
+func (m *memoryFaqSectionRepository) FetchByIDFaqSection(ctx context.Context, id int64) (*entity.FaqSectionEntity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	faq, ok := m.faqs[id]
+	if !ok {
+		return nil, errs.NotFound("faq_section_not_found", fmt.Sprintf("faq section %d not found", id))
+	}
+	return &faq, nil
+}
+
+func (m *memoryFaqSectionRepository) EditByIDFaqSection(ctx context.Context, req entity.FaqSectionEntity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.faqs[req.ID]; !ok {
+		return errs.NotFound("faq_section_not_found", fmt.Sprintf("faq section %d not found", req.ID))
+	}
+	m.faqs[req.ID] = req
+	return nil
+}
+
+func (m *memoryFaqSectionRepository) DeleteByIDFaqSection(ctx context.Context, id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.faqs[id]; !ok {
+		return errs.NotFound("faq_section_not_found", fmt.Sprintf("faq section %d not found", id))
+	}
+	delete(m.faqs, id)
+	return nil
+}
+
+type memoryUserRepository struct{}
+
+func (m *memoryUserRepository) GetUserByEmail(ctx context.Context, email string) (*entity.UserEntity, error) {
+	return nil, errs.NotFound("user_not_found", fmt.Sprintf("user %s not found", email))
+}
+
+func newConcurrencyTestEcho(t *testing.T) (*echo.Echo, string) {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.App.JwtSecretKey = "concurrency-test"
+
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	customValidator := validator.NewValidator()
+	e.Validator = customValidator
+
+	faqRepo := &memoryFaqSectionRepository{faqs: map[int64]entity.FaqSectionEntity{}}
+	faqService := service.NewFaqSectionService(faqRepo, nil, cache.NewMemory(time.Minute, 100))
+	NewFaqSectionHandler(e, faqService, cfg)
+
+	jwtAuth := auth.NewJwt(cfg)
+	NewUserHandler(e, service.NewUserService(&memoryUserRepository{}, cfg, jwtAuth))
+
+	token, _, err := jwtAuth.GenerateToken(&entity.JwtData{UserID: 1})
+	if err != nil {
+		t.Fatalf("generating token: %v", err)
+	}
+	return e, token
+}
+
+type concurrentRequest struct {
+	method  string
+	path    string
+	body    string
+	status  int
+	message string
+}
+
+// TestConcurrentRequestErrors fires requests that fail in different ways at
+// the same time, run it with -race. Every response has to carry the error of
+// its own request.
+func TestConcurrentRequestErrors(t *testing.T) {
+	e, token := newConcurrencyTestEcho(t)
+
+	var requests []concurrentRequest
+	for i := 0; i < 50; i++ {
+		requests = append(requests,
+			concurrentRequest{
+				method:  http.MethodPost,
+				path:    "/faq-sections/admin",
+				body:    fmt.Sprintf(`{"title":"fail-%d","description":"description %d"}`, i, i),
+				status:  http.StatusConflict,
+				message: fmt.Sprintf("faq section fail-%d already exists", i),
+			},
+			concurrentRequest{
+				method:  http.MethodPost,
+				path:    "/faq-sections/admin",
+				body:    fmt.Sprintf(`{"title":"faq-%d","description":"description %d"}`, i, i),
+				status:  http.StatusCreated,
+				message: "Success create faq section",
+			},
+			concurrentRequest{
+				method:  http.MethodGet,
+				path:    fmt.Sprintf("/faq-sections/admin/%d", 1000+i),
+				status:  http.StatusNotFound,
+				message: fmt.Sprintf("faq section %d not found", 1000+i),
+			},
+			concurrentRequest{
+				method:  http.MethodDelete,
+				path:    fmt.Sprintf("/faq-sections/admin/%d", 2000+i),
+				status:  http.StatusNotFound,
+				message: fmt.Sprintf("faq section %d not found", 2000+i),
+			},
+			concurrentRequest{
+				method:  http.MethodGet,
+				path:    fmt.Sprintf("/faq-sections/admin/faq-%d", i),
+				status:  http.StatusBadRequest,
+				message: "given param is not valid",
+			},
+			concurrentRequest{
+				method:  http.MethodPost,
+				path:    "/login",
+				body:    fmt.Sprintf(`{"email":"admin%d@example.com","password":"password%d"}`, i, i),
+				status:  http.StatusUnauthorized,
+				message: "wrong email/password",
+			},
+		)
+	}
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for _, val := range requests {
+		wg.Add(1)
+		go func(val concurrentRequest) {
+			defer wg.Done()
+			<-start
+
+			req := httptest.NewRequest(val.method, val.path, strings.NewReader(val.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			// Error responses inline the meta fields, success responses nest them.
+			var body struct {
+				response.Meta
+				Success response.Meta `json:"meta"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Errorf("%s %s: decoding response: %v", val.method, val.path, err)
+				return
+			}
+			message := body.Message
+			if rec.Code < http.StatusBadRequest {
+				message = body.Success.Message
+			}
+			if rec.Code != val.status || message != val.message {
+				t.Errorf("%s %s %s returned %d %q, want %d %q", val.method, val.path, val.body, rec.Code, message, val.status, val.message)
+			}
+		}(val)
+	}
+	close(start)
+	wg.Wait()
+}
@@ -11,7 +11,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type ContactUsHandlerInterface interface {
@@ -30,32 +30,25 @@ type contactUsHandler struct {
 // CreateContactUs implements ContactUsHandlerInterface.
 func (cs *contactUsHandler) CreateContactUs(c echo.Context) error {
 	var (
-		req       = request.ContactUsRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.ContactUsRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreateContactUs - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateContactUs - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateContactUs - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateContactUs - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateContactUs - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateContactUs - 3")
+		return err
 	}
 
 	reqEntity := entity.ContactUsEntity{
@@ -65,12 +58,10 @@ func (cs *contactUsHandler) CreateContactUs(c echo.Context) error {
 		PhoneNumber:  req.PhoneNumber,
 	}
 
-	err = cs.contactUsService.CreateContactUs(ctx, reqEntity)
+	err := cs.contactUsService.CreateContactUs(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateContactUs - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateContactUs - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create contact us"
@@ -84,25 +75,20 @@ func (cs *contactUsHandler) CreateContactUs(c echo.Context) error {
 func (cs *contactUsHandler) FetchAllContactUs(c echo.Context) error {
 	var (
 		resp          = response.DefaultSuccessResponse{}
-		respError     = response.ErrorResponseDefault{}
 		ctx           = c.Request().Context()
 		respContactUs = []response.ContactUsResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllContactUs - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllContactUs - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.contactUsService.FetchAllContactUs(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllContactUs - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllContactUs - 2")
+		return err
 	}
 
 	for _, val := range results {
@@ -126,34 +112,27 @@ func (cs *contactUsHandler) FetchAllContactUs(c echo.Context) error {
 func (cs *contactUsHandler) FetchByIDContactUs(c echo.Context) error {
 	var (
 		resp          = response.DefaultSuccessResponse{}
-		respError     = response.ErrorResponseDefault{}
 		ctx           = c.Request().Context()
 		respContactUs = response.ContactUsResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDContactUs - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDContactUs - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idContactUs := c.Param("id")
 	id, err := conv.StringToInt64(idContactUs)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDContactUs - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDContactUs - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.contactUsService.FetchByIDContactUs(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDContactUs - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDContactUs - 3")
+		return err
 	}
 
 	respContactUs.ID = result.ID
@@ -171,41 +150,32 @@ func (cs *contactUsHandler) FetchByIDContactUs(c echo.Context) error {
 // EditByIDContactUs implements ContactUsHandlerInterface.
 func (cs *contactUsHandler) EditByIDContactUs(c echo.Context) error {
 	var (
-		req       = request.ContactUsRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.ContactUsRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDContactUs - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDContactUs - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idContactUs := c.Param("id")
 	id, err := conv.StringToInt64(idContactUs)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDContactUs - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDContactUs - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDContactUs - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDContactUs - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDContactUs - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDContactUs - 4")
+		return err
 	}
 
 	reqEntity := entity.ContactUsEntity{
@@ -218,10 +188,8 @@ func (cs *contactUsHandler) EditByIDContactUs(c echo.Context) error {
 
 	err = cs.contactUsService.EditByIDContactUs(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDContactUs - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDContactUs - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit contact us"
 	resp.Meta.Status = true
@@ -233,34 +201,27 @@ func (cs *contactUsHandler) EditByIDContactUs(c echo.Context) error {
 // DeleteByIDContactUs implements ContactUsHandlerInterface.
 func (cs *contactUsHandler) DeleteByIDContactUs(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDContactUs - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDContactUs - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idContactUs := c.Param("id")
 	id, err := conv.StringToInt64(idContactUs)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDContactUs - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDContactUs - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.contactUsService.DeleteByIDContactUs(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDContactUs - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDContactUs - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete contact us"
 	resp.Meta.Status = true
@@ -275,16 +236,13 @@ func (cs *contactUsHandler) FetchAllContactUsHome(c echo.Context) error {
 	var (
 		respContactUs = response.ContactUsResponse{}
 		resp          = response.DefaultSuccessResponse{}
-		respError     = response.ErrorResponseDefault{}
 		ctx           = c.Request().Context()
 	)
 
 	results, err := cs.contactUsService.FetchAllContactUs(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllContactUsHome - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllContactUsHome - 1")
+		return err
 	}
 
 	respContactUs = response.ContactUsResponse{
@@ -309,7 +267,7 @@ func NewContactUsHandler(e *echo.Echo, contactUsService service.ContactUsService
 	mid := middleware.NewMiddleware(cfg)
 
 	contactUsApp := e.Group("/contact-us")
-	contactUsApp.GET("", h.FetchAllContactUsHome)
+	contactUsApp.GET("", h.FetchAllContactUsHome, mid.Locale())
 
 	adminApp := contactUsApp.Group("/admin", mid.CheckToken())
 
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/request"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type ContentPositionHandlerInterface interface {
+	ReorderContent(c echo.Context) error
+}
+
+type contentPositionHandler struct {
+	positionService service.ContentPositionServiceInterface
+}
+
+// ReorderContent implements ContentPositionHandlerInterface.
+func (cs *contentPositionHandler) ReorderContent(c echo.Context) error {
+	var (
+		req  = request.ReorderRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] ReorderContent - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] ReorderContent - 2")
+		return bindError(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] ReorderContent - 3")
+		return err
+	}
+
+	err := cs.positionService.ReorderContent(ctx, contentTypeFromPath(c), req.IDs)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] ReorderContent - 4")
+		return err
+	}
+
+	resp.Meta.Message = "Success reorder content"
+	resp.Meta.Status = true
+	resp.Data = nil
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+func NewContentPositionHandler(e *echo.Echo, positionService service.ContentPositionServiceInterface, cfg *config.Config) ContentPositionHandlerInterface {
+	h := &contentPositionHandler{
+		positionService: positionService,
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	for _, contentType := range entity.SortableContentTypes {
+		e.PUT("/"+contentType+"/admin/reorder", h.ReorderContent, mid.CheckToken())
+	}
+
+	return h
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"encoding/json"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/errs"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type ContentRevisionHandlerInterface interface {
+	FetchAllRevision(c echo.Context) error
+	DiffRevision(c echo.Context) error
+	RestoreRevision(c echo.Context) error
+}
+
+type contentRevisionHandler struct {
+	revisionService service.ContentRevisionServiceInterface
+}
+
+// FetchAllRevision implements ContentRevisionHandlerInterface.
+func (cs *contentRevisionHandler) FetchAllRevision(c echo.Context) error {
+	var (
+		resp          = response.DefaultSuccessResponse{}
+		ctx           = c.Request().Context()
+		respRevisions = []response.ContentRevisionResponse{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllRevision - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllRevision - 2")
+		return conv.ErrBadParamInput
+	}
+
+	results, err := cs.revisionService.FetchAllRevision(ctx, c.Param("type"), id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllRevision - 3")
+		return err
+	}
+
+	for _, val := range results {
+		respRevisions = append(respRevisions, response.ContentRevisionResponse{
+			ID:          val.ID,
+			ContentType: val.ContentType,
+			ContentID:   val.ContentID,
+			Version:     val.Version,
+			AuthorID:    val.AuthorID,
+			Note:        val.Note,
+			Snapshot:    json.RawMessage(val.Snapshot),
+			CreatedAt:   val.CreatedAt,
+		})
+	}
+
+	resp.Meta.Message = "Success fetch all revision"
+	resp.Meta.Status = true
+	resp.Data = respRevisions
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DiffRevision implements ContentRevisionHandlerInterface.
+func (cs *contentRevisionHandler) DiffRevision(c echo.Context) error {
+	var (
+		resp      = response.DefaultSuccessResponse{}
+		ctx       = c.Request().Context()
+		respDiffs = []response.ContentRevisionDiffResponse{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] DiffRevision - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DiffRevision - 2")
+		return conv.ErrBadParamInput
+	}
+
+	from, err := conv.StringToInt64(c.QueryParam("from"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DiffRevision - 3")
+		return errs.Wrap(err, errs.KindValidation, "invalid_query_param", "query param from must be a version number")
+	}
+
+	to, err := conv.StringToInt64(c.QueryParam("to"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DiffRevision - 4")
+		return errs.Wrap(err, errs.KindValidation, "invalid_query_param", "query param to must be a version number")
+	}
+
+	results, err := cs.revisionService.DiffRevision(ctx, c.Param("type"), id, from, to)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DiffRevision - 5")
+		return err
+	}
+
+	for _, val := range results {
+		respDiffs = append(respDiffs, response.ContentRevisionDiffResponse{
+			Field: val.Field,
+			From:  val.From,
+			To:    val.To,
+		})
+	}
+
+	resp.Meta.Message = "Success diff revision"
+	resp.Meta.Status = true
+	resp.Data = respDiffs
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// RestoreRevision implements ContentRevisionHandlerInterface.
+func (cs *contentRevisionHandler) RestoreRevision(c echo.Context) error {
+	var (
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] RestoreRevision - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] RestoreRevision - 2")
+		return conv.ErrBadParamInput
+	}
+
+	version, err := conv.StringToInt64(c.Param("version"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] RestoreRevision - 3")
+		return conv.ErrBadParamInput
+	}
+
+	err = cs.revisionService.RestoreRevision(ctx, c.Param("type"), id, version)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] RestoreRevision - 4")
+		return err
+	}
+
+	resp.Meta.Message = "Success restore revision"
+	resp.Meta.Status = true
+	resp.Data = nil
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+func NewContentRevisionHandler(e *echo.Echo, revisionService service.ContentRevisionServiceInterface, cfg *config.Config) ContentRevisionHandlerInterface {
+	h := &contentRevisionHandler{
+		revisionService: revisionService,
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	revisionApp := e.Group("/revisions")
+	adminApp := revisionApp.Group("/admin", mid.CheckToken())
+
+	adminApp.GET("/:type/:id", h.FetchAllRevision)
+	adminApp.GET("/:type/:id/diff", h.DiffRevision)
+	adminApp.POST("/:type/:id/:version/restore", h.RestoreRevision)
+
+	return h
+}
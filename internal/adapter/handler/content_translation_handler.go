@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/request"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type ContentTranslationHandlerInterface interface {
+	FetchAllTranslation(c echo.Context) error
+	UpsertTranslation(c echo.Context) error
+	DeleteTranslation(c echo.Context) error
+	FetchMissingTranslation(c echo.Context) error
+}
+
+type contentTranslationHandler struct {
+	translationService service.ContentTranslationServiceInterface
+}
+
+// FetchAllTranslation implements ContentTranslationHandlerInterface.
+func (cs *contentTranslationHandler) FetchAllTranslation(c echo.Context) error {
+	var (
+		resp             = response.DefaultSuccessResponse{}
+		ctx              = c.Request().Context()
+		respTranslations = []response.ContentTranslationResponse{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllTranslation - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllTranslation - 2")
+		return conv.ErrBadParamInput
+	}
+
+	results, err := cs.translationService.FetchAllTranslation(ctx, c.Param("type"), id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllTranslation - 3")
+		return err
+	}
+
+	for _, val := range results {
+		respTranslations = append(respTranslations, response.ContentTranslationResponse{
+			ID:        val.ID,
+			Locale:    val.Locale,
+			Field:     val.Field,
+			Value:     val.Value,
+			UpdatedAt: val.UpdatedAt,
+		})
+	}
+
+	resp.Meta.Message = "Success fetch all translation"
+	resp.Meta.Status = true
+	resp.Data = respTranslations
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// UpsertTranslation implements ContentTranslationHandlerInterface.
+func (cs *contentTranslationHandler) UpsertTranslation(c echo.Context) error {
+	var (
+		req  = request.ContentTranslationRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] UpsertTranslation - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] UpsertTranslation - 2")
+		return conv.ErrBadParamInput
+	}
+
+	if err = c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] UpsertTranslation - 3")
+		return bindError(err)
+	}
+
+	if err = c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] UpsertTranslation - 4")
+		return err
+	}
+
+	err = cs.translationService.UpsertTranslation(ctx, c.Param("type"), id, c.Param("locale"), req.Fields)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] UpsertTranslation - 5")
+		return err
+	}
+
+	resp.Meta.Message = "Success save translation"
+	resp.Meta.Status = true
+	resp.Data = nil
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteTranslation implements ContentTranslationHandlerInterface.
+func (cs *contentTranslationHandler) DeleteTranslation(c echo.Context) error {
+	var (
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteTranslation - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteTranslation - 2")
+		return conv.ErrBadParamInput
+	}
+
+	err = cs.translationService.DeleteTranslation(ctx, c.Param("type"), id, c.Param("locale"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteTranslation - 3")
+		return err
+	}
+
+	resp.Meta.Message = "Success delete translation"
+	resp.Meta.Status = true
+	resp.Data = nil
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// FetchMissingTranslation implements ContentTranslationHandlerInterface.
+func (cs *contentTranslationHandler) FetchMissingTranslation(c echo.Context) error {
+	var (
+		resp         = response.DefaultSuccessResponse{}
+		ctx          = c.Request().Context()
+		respMissings = []response.MissingTranslationResponse{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchMissingTranslation - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	results, err := cs.translationService.FetchMissingTranslation(ctx, c.QueryParam("locale"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchMissingTranslation - 2")
+		return err
+	}
+
+	for _, val := range results {
+		respMissings = append(respMissings, response.MissingTranslationResponse{
+			ContentType: val.ContentType,
+			ContentID:   val.ContentID,
+			Locale:      val.Locale,
+			Fields:      val.Fields,
+		})
+	}
+
+	resp.Meta.Message = "Success fetch missing translation"
+	resp.Meta.Status = true
+	resp.Data = respMissings
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+func NewContentTranslationHandler(e *echo.Echo, translationService service.ContentTranslationServiceInterface, cfg *config.Config) ContentTranslationHandlerInterface {
+	h := &contentTranslationHandler{
+		translationService: translationService,
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	translationApp := e.Group("/translations")
+	adminApp := translationApp.Group("/admin", mid.CheckToken())
+
+	adminApp.GET("/missing", h.FetchMissingTranslation)
+	adminApp.GET("/:type/:id", h.FetchAllTranslation)
+	adminApp.PUT("/:type/:id/:locale", h.UpsertTranslation)
+	adminApp.DELETE("/:type/:id/:locale", h.DeleteTranslation)
+
+	return h
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/errs"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/validator"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// HTTPErrorHandler renders the errors returned by handlers and middlewares as
+// an ErrorResponseDefault. Errors of a known kind keep their message and code,
+// anything else is logged and reported as an internal server error so causes
+// such as SQL errors are not leaked to clients. Validation errors also list
+// their failed rules, translated to the locale of the request.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	respError := response.ErrorResponseDefault{}
+	respError.Meta.Status = false
+
+	var (
+		status  int
+		httpErr *echo.HTTPError
+	)
+	switch kind := errs.KindOf(err); {
+	case errors.As(err, &httpErr):
+		status = httpErr.Code
+		respError.Meta.Message = fmt.Sprint(httpErr.Message)
+		respError.Code = statusCode(status)
+	case kind == errs.KindInternal:
+		log.Ctx(c.Request().Context()).Error().Err(err).Msg("[HANDLER] HTTPErrorHandler - 1")
+		status = http.StatusInternalServerError
+		respError.Meta.Message = conv.ErrInternalServerError.Error()
+		respError.Code = string(errs.KindInternal)
+	case kind == errs.KindTimeout:
+		status = http.StatusGatewayTimeout
+		respError.Meta.Message = "request timed out"
+		respError.Code = string(errs.KindTimeout)
+	default:
+		status = conv.SetHTTPStatusCode(err)
+		respError.Meta.Message = err.Error()
+		respError.Code = errs.CodeOf(err)
+	}
+
+	var validationErr *validator.ValidationError
+	if errors.As(err, &validationErr) {
+		locale := validator.MatchLocale(c.QueryParam("lang"), c.Request().Header.Get("Accept-Language"))
+		var messages []string
+		for _, val := range validationErr.Fields(locale) {
+			respError.Errors = append(respError.Errors, response.FieldErrorResponse{
+				Field:   val.Field,
+				Rule:    val.Rule,
+				Message: val.Message,
+			})
+			messages = append(messages, val.Message)
+		}
+		respError.Meta.Message = strings.Join(messages, "; ")
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(status)
+	} else {
+		err = c.JSON(status, respError)
+	}
+	if err != nil {
+		log.Ctx(c.Request().Context()).Error().Err(err).Msg("[HANDLER] HTTPErrorHandler - 2")
+	}
+}
+
+// bindError reports a request that could not be bound as unprocessable,
+// with the message of echo's error rather than its internal cause.
+func bindError(err error) error {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, httpErr.Message).SetInternal(err)
+	}
+	return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error()).SetInternal(err)
+}
+
+// statusCode derives the code of an echo error from its status, e.g.
+// method_not_allowed.
+func statusCode(status int) string {
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+}
@@ -11,7 +11,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type FaqSectionHandlerInterface interface {
@@ -31,22 +31,20 @@ type faqSectionHandler struct {
 // FetchAllFaqSectionHome implements FaqSectionHandlerInterface.
 func (cs *faqSectionHandler) FetchAllFaqSectionHome(c echo.Context) error {
 	var (
-		respFaqs  = []response.FaqSectionResponse{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		respFaqs = []response.FaqSectionResponse{}
+		resp     = response.DefaultSuccessResponse{}
+		ctx      = c.Request().Context()
 	)
 
 	results, err := cs.faqSectionService.FetchAllFaqSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllFaqSectionHome - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllFaqSectionHome - 1")
+		return err
 	}
 	for _, val := range results {
 		respFaqs = append(respFaqs, response.FaqSectionResponse{
 			ID:          val.ID,
+			Position:    val.Position,
 			Title:       val.Title,
 			Description: val.Description,
 		})
@@ -61,32 +59,25 @@ func (cs *faqSectionHandler) FetchAllFaqSectionHome(c echo.Context) error {
 // CreateFaqSection implements FaqSectionHandlerInterface.
 func (cs *faqSectionHandler) CreateFaqSection(c echo.Context) error {
 	var (
-		req       = request.FaqSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.FaqSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreateFaqSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateFaqSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateFaqSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateFaqSection - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateFaqSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateFaqSection - 3")
+		return err
 	}
 
 	reqEntity := entity.FaqSectionEntity{
@@ -94,12 +85,10 @@ func (cs *faqSectionHandler) CreateFaqSection(c echo.Context) error {
 		Description: req.Description,
 	}
 
-	err = cs.faqSectionService.CreateFaqSection(ctx, reqEntity)
+	err := cs.faqSectionService.CreateFaqSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateFaqSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateFaqSection - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create faq section"
@@ -112,34 +101,27 @@ func (cs *faqSectionHandler) CreateFaqSection(c echo.Context) error {
 // DeleteByIDFaqSection implements FaqSectionHandlerInterface.
 func (cs *faqSectionHandler) DeleteByIDFaqSection(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDFaqSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDFaqSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idFaqSection := c.Param("id")
 	id, err := conv.StringToInt64(idFaqSection)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDFaqSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDFaqSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.faqSectionService.DeleteByIDFaqSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDFaqSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDFaqSection - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete faq section"
 	resp.Meta.Status = true
@@ -152,41 +134,32 @@ func (cs *faqSectionHandler) DeleteByIDFaqSection(c echo.Context) error {
 // EditByIDFaqSection implements FaqSectionHandlerInterface.
 func (cs *faqSectionHandler) EditByIDFaqSection(c echo.Context) error {
 	var (
-		req       = request.FaqSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.FaqSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDFaqSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDFaqSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idFaqSection := c.Param("id")
 	id, err := conv.StringToInt64(idFaqSection)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDFaqSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDFaqSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDFaqSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDFaqSection - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDFaqSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDFaqSection - 4")
+		return err
 	}
 
 	reqEntity := entity.FaqSectionEntity{
@@ -197,10 +170,8 @@ func (cs *faqSectionHandler) EditByIDFaqSection(c echo.Context) error {
 
 	err = cs.faqSectionService.EditByIDFaqSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDFaqSection - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDFaqSection - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit faq section"
 	resp.Meta.Status = true
@@ -213,30 +184,26 @@ func (cs *faqSectionHandler) EditByIDFaqSection(c echo.Context) error {
 func (cs *faqSectionHandler) FetchAllFaqSection(c echo.Context) error {
 	var (
 		resp           = response.DefaultSuccessResponse{}
-		respError      = response.ErrorResponseDefault{}
 		ctx            = c.Request().Context()
 		respFaqSection = []response.FaqSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllFaqSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllFaqSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.faqSectionService.FetchAllFaqSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllFaqSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllFaqSection - 2")
+		return err
 	}
 
 	for _, val := range results {
 		respFaqSection = append(respFaqSection, response.FaqSectionResponse{
 			ID:          val.ID,
+			Position:    val.Position,
 			Title:       val.Title,
 			Description: val.Description,
 		})
@@ -253,37 +220,31 @@ func (cs *faqSectionHandler) FetchAllFaqSection(c echo.Context) error {
 func (cs *faqSectionHandler) FetchByIDFaqSection(c echo.Context) error {
 	var (
 		resp           = response.DefaultSuccessResponse{}
-		respError      = response.ErrorResponseDefault{}
 		ctx            = c.Request().Context()
 		respFaqSection = response.FaqSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDFaqSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDFaqSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idFaqSection := c.Param("id")
 	id, err := conv.StringToInt64(idFaqSection)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDFaqSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDFaqSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.faqSectionService.FetchByIDFaqSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDFaqSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDFaqSection - 3")
+		return err
 	}
 
 	respFaqSection.ID = result.ID
+	respFaqSection.Position = result.Position
 	respFaqSection.Title = result.Title
 	respFaqSection.Description = result.Description
 	resp.Meta.Message = "Success fetch hero section by ID"
@@ -301,7 +262,7 @@ func NewFaqSectionHandler(e *echo.Echo, faqSectionService service.FaqSectionServ
 	mid := middleware.NewMiddleware(cfg)
 
 	faqApp := e.Group("/faq-sections")
-	faqApp.GET("", h.FetchAllFaqSectionHome)
+	faqApp.GET("", h.FetchAllFaqSectionHome, mid.Locale())
 
 	adminApp := faqApp.Group("/admin", mid.CheckToken())
 
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"latihan-compro/config"
+	"latihan-compro/utils/health"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type HealthHandlerInterface interface {
+	FetchLiveness(c echo.Context) error
+	FetchReadiness(c echo.Context) error
+}
+
+type healthHandler struct {
+	health health.HealthInterface
+	token  string
+}
+
+// FetchLiveness implements HealthHandlerInterface.
+func (h *healthHandler) FetchLiveness(c echo.Context) error {
+	return h.respond(c, h.health.Live(c.Request().Context()))
+}
+
+// FetchReadiness implements HealthHandlerInterface.
+// Degraded components still answer 200, a failing required one or a shutdown
+// in progress answers 503. The errors of components are logged, and only
+// shown to clients sending METRICS_TOKEN as a bearer token.
+func (h *healthHandler) FetchReadiness(c echo.Context) error {
+	report := h.health.Ready(c.Request().Context())
+	if h.token == "" || !hasBearer(c, h.token) {
+		report = report.WithoutErrors()
+	}
+	return h.respond(c, report)
+}
+
+func (h *healthHandler) respond(c echo.Context, report health.Report) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	if !report.Healthy() {
+		return c.JSON(http.StatusServiceUnavailable, report)
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+func NewHealthHandler(e *echo.Echo, health health.HealthInterface, cfg *config.Config) HealthHandlerInterface {
+	h := &healthHandler{
+		health: health,
+		token:  cfg.Metrics.Token,
+	}
+
+	e.GET("/healthz", h.FetchLiveness)
+	e.GET("/readyz", h.FetchReadiness)
+
+	return h
+}
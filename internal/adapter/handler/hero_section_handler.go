@@ -5,13 +5,15 @@ import (
 	"latihan-compro/internal/adapter/handler/request"
 	"latihan-compro/internal/adapter/handler/response"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type HeroSectionHandlerInterface interface {
@@ -30,32 +32,25 @@ type heroSectionHandler struct {
 // CreateHeroSection implements HeroSectionHandlerInterface.
 func (h *heroSectionHandler) CreateHeroSection(c echo.Context) error {
 	var (
-		req       = request.HeroSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.HeroSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreateHeroSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateHeroSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateHeroSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateHeroSection - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateHeroSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateHeroSection - 3")
+		return err
 	}
 
 	reqEntity := entity.HeroSectionEntity{
@@ -65,12 +60,10 @@ func (h *heroSectionHandler) CreateHeroSection(c echo.Context) error {
 		Banner:     req.Banner,
 	}
 
-	err = h.heroSectionService.CreateHeroSection(ctx, reqEntity)
+	err := h.heroSectionService.CreateHeroSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateHeroSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateHeroSection - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create hero section"
@@ -83,34 +76,30 @@ func (h *heroSectionHandler) CreateHeroSection(c echo.Context) error {
 // FetchAllHeroSection implements HeroSectionHandlerInterface.
 func (h *heroSectionHandler) FetchAllHeroSection(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
-		respHero  = []response.HeroSectionResponse{}
+		resp     = response.DefaultSuccessResponse{}
+		ctx      = c.Request().Context()
+		respHero = []response.HeroSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllHeroSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllHeroSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 	results, err := h.heroSectionService.FetchAllHeroSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllHeroSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllHeroSection - 2")
+		return err
 	}
 
 	for _, val := range results {
 		respHero = append(respHero, response.HeroSectionResponse{
-			ID:         val.ID,
-			Heading:    val.Heading,
-			SubHeading: val.SubHeading,
-			PathVideo:  val.PathVideo,
-			Banner:     val.Banner,
+			ID:             val.ID,
+			Heading:        val.Heading,
+			SubHeading:     val.SubHeading,
+			PathVideo:      val.PathVideo,
+			Banner:         val.Banner,
+			BannerVariants: upload.ImageVariants(val.Banner),
 		})
 	}
 
@@ -124,35 +113,28 @@ func (h *heroSectionHandler) FetchAllHeroSection(c echo.Context) error {
 // FetchByIDHeroSection implements HeroSectionHandlerInterface.
 func (h *heroSectionHandler) FetchByIDHeroSection(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
-		respHero  = response.HeroSectionResponse{}
+		resp     = response.DefaultSuccessResponse{}
+		ctx      = c.Request().Context()
+		respHero = response.HeroSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDHeroSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDHeroSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idHero := c.Param("id")
 	id, err := conv.StringToInt64(idHero)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDHeroSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDHeroSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := h.heroSectionService.FetchByIDHeroSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDHeroSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDHeroSection - 3")
+		return err
 	}
 
 	respHero.ID = result.ID
@@ -160,6 +142,7 @@ func (h *heroSectionHandler) FetchByIDHeroSection(c echo.Context) error {
 	respHero.SubHeading = result.SubHeading
 	respHero.PathVideo = result.PathVideo
 	respHero.Banner = result.Banner
+	respHero.BannerVariants = upload.ImageVariants(result.Banner)
 	resp.Meta.Message = "Success fetch hero section by ID"
 	resp.Meta.Status = true
 	resp.Data = respHero
@@ -170,41 +153,32 @@ func (h *heroSectionHandler) FetchByIDHeroSection(c echo.Context) error {
 // EditByIDHeroSection implements HeroSectionHandlerInterface.
 func (h *heroSectionHandler) EditByIDHeroSection(c echo.Context) error {
 	var (
-		req       = request.HeroSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.HeroSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDHeroSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDHeroSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idHero := c.Param("id")
 	id, err := conv.StringToInt64(idHero)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDHeroSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDHeroSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDHeroSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDHeroSection - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDHeroSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDHeroSection - 4")
+		return err
 	}
 
 	reqEntity := entity.HeroSectionEntity{
@@ -217,10 +191,8 @@ func (h *heroSectionHandler) EditByIDHeroSection(c echo.Context) error {
 
 	err = h.heroSectionService.EditByIDHeroSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDHeroSection - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDHeroSection - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit hero section"
 	resp.Meta.Status = true
@@ -232,34 +204,27 @@ func (h *heroSectionHandler) EditByIDHeroSection(c echo.Context) error {
 // DeleteByIDHeroSection implements HeroSectionHandlerInterface.
 func (h *heroSectionHandler) DeleteByIDHeroSection(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDHeroSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDHeroSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idHero := c.Param("id")
 	id, err := conv.StringToInt64(idHero)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDHeroSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDHeroSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = h.heroSectionService.DeleteByIDHeroSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDHeroSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDHeroSection - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete hero section"
 	resp.Meta.Status = true
@@ -272,21 +237,24 @@ func (h *heroSectionHandler) DeleteByIDHeroSection(c echo.Context) error {
 // FetchHeroDataHome implements HeroSectionHandlerInterface.
 func (h *heroSectionHandler) FetchHeroDataHome(c echo.Context) error {
 	var (
-		respHero  = response.HeroSectionResponse{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		respHero = response.HeroSectionResponse{}
+		resp     = response.DefaultSuccessResponse{}
+		ctx      = c.Request().Context()
 	)
 
 	results, err := h.heroSectionService.FetchAllHeroSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchHeroDataHome - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchHeroDataHome - 1")
+		return err
+	}
+
+	if len(results) == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchHeroDataHome - 2: no hero section")
+		return errs.NotFound("hero_section_not_found", "hero section not found")
 	}
 
 	respHero.Banner = results[0].Banner
+	respHero.BannerVariants = upload.ImageVariants(results[0].Banner)
 	respHero.Heading = results[0].Heading
 	respHero.SubHeading = results[0].SubHeading
 	respHero.PathVideo = results[0].PathVideo
@@ -308,7 +276,7 @@ func NewHeroSectionHandler(c *echo.Echo, cfg *config.Config, heroSectionService
 
 	heroApp := c.Group("/hero-sections")
 
-	heroApp.GET("", heroHandler.FetchHeroDataHome)
+	heroApp.GET("", heroHandler.FetchHeroDataHome, mid.Locale())
 
 	adminApp := heroApp.Group("/admin", mid.CheckToken())
 	adminApp.GET("", heroHandler.FetchAllHeroSection)
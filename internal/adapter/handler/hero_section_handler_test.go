@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"latihan-compro/config"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/service"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+type emptyHeroSectionService struct {
+	service.HeroSectionServiceInterface
+}
+
+func (e *emptyHeroSectionService) FetchAllHeroSection(ctx context.Context) ([]entity.HeroSectionEntity, error) {
+	return nil, nil
+}
+
+func TestFetchHeroDataHomeWithoutHeroSection(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	NewHeroSectionHandler(e, &config.Config{}, &emptyHeroSectionService{})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/hero-sections", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("empty hero table = %d: %s, want 404", rec.Code, rec.Body)
+	}
+}
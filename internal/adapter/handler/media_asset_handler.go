@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/request"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type MediaAssetHandlerInterface interface {
+	FetchAllMediaAsset(c echo.Context) error
+	FetchByIDMediaAsset(c echo.Context) error
+	FetchMediaAssetReference(c echo.Context) error
+	EditAltTextMediaAsset(c echo.Context) error
+	DeleteByIDMediaAsset(c echo.Context) error
+	FetchOrphanMediaAsset(c echo.Context) error
+}
+
+type mediaAssetHandler struct {
+	mediaAssetService service.MediaAssetServiceInterface
+}
+
+// FetchAllMediaAsset implements MediaAssetHandlerInterface.
+func (cs *mediaAssetHandler) FetchAllMediaAsset(c echo.Context) error {
+	var (
+		resp            = response.DefaultSuccessResponse{}
+		ctx             = c.Request().Context()
+		respMediaAssets = []response.MediaAssetResponse{}
+		filter          = entity.MediaAssetFilterEntity{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllMediaAsset - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	filter.Search = c.QueryParam("search")
+	filter.ContentType = c.QueryParam("content_type")
+	filter.Page, _ = strconv.Atoi(c.QueryParam("page"))
+	filter.PerPage, _ = strconv.Atoi(c.QueryParam("per_page"))
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PerPage < 1 || filter.PerPage > 100 {
+		filter.PerPage = 20
+	}
+
+	results, total, err := cs.mediaAssetService.FetchAllMediaAsset(ctx, filter)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllMediaAsset - 2")
+		return err
+	}
+
+	for _, val := range results {
+		respMediaAssets = append(respMediaAssets, toMediaAssetResponse(val))
+	}
+
+	resp.Meta.Message = "Success fetch all media asset"
+	resp.Meta.Status = true
+	resp.Data = respMediaAssets
+	resp.Pagination = &response.PaginationResponse{
+		TotalRecords: int(total),
+		Page:         filter.Page,
+		PerPage:      filter.PerPage,
+		TotalPages:   (int(total) + filter.PerPage - 1) / filter.PerPage,
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// FetchByIDMediaAsset implements MediaAssetHandlerInterface.
+func (cs *mediaAssetHandler) FetchByIDMediaAsset(c echo.Context) error {
+	var (
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDMediaAsset - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDMediaAsset - 2")
+		return conv.ErrBadParamInput
+	}
+
+	result, err := cs.mediaAssetService.FetchByIDMediaAsset(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDMediaAsset - 3")
+		return err
+	}
+
+	resp.Meta.Message = "Success fetch media asset by ID"
+	resp.Meta.Status = true
+	resp.Data = toMediaAssetResponse(*result)
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// FetchMediaAssetReference implements MediaAssetHandlerInterface.
+func (cs *mediaAssetHandler) FetchMediaAssetReference(c echo.Context) error {
+	var (
+		resp           = response.DefaultSuccessResponse{}
+		ctx            = c.Request().Context()
+		respReferences = []response.MediaAssetReferenceResponse{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchMediaAssetReference - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchMediaAssetReference - 2")
+		return conv.ErrBadParamInput
+	}
+
+	results, err := cs.mediaAssetService.FetchMediaAssetReference(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchMediaAssetReference - 3")
+		return err
+	}
+
+	for _, val := range results {
+		respReferences = append(respReferences, response.MediaAssetReferenceResponse{
+			ContentType: val.ContentType,
+			ContentID:   val.ContentID,
+			Field:       val.Field,
+		})
+	}
+
+	resp.Meta.Message = "Success fetch media asset reference"
+	resp.Meta.Status = true
+	resp.Data = respReferences
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// EditAltTextMediaAsset implements MediaAssetHandlerInterface.
+func (cs *mediaAssetHandler) EditAltTextMediaAsset(c echo.Context) error {
+	var (
+		req  = request.MediaAssetRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditAltTextMediaAsset - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditAltTextMediaAsset - 2")
+		return conv.ErrBadParamInput
+	}
+
+	if err = c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditAltTextMediaAsset - 3")
+		return bindError(err)
+	}
+
+	if err = c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditAltTextMediaAsset - 4")
+		return err
+	}
+
+	err = cs.mediaAssetService.EditAltTextMediaAsset(ctx, id, req.AltText)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditAltTextMediaAsset - 5")
+		return err
+	}
+
+	resp.Meta.Message = "Success edit media asset"
+	resp.Meta.Status = true
+	resp.Data = nil
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteByIDMediaAsset implements MediaAssetHandlerInterface.
+func (cs *mediaAssetHandler) DeleteByIDMediaAsset(c echo.Context) error {
+	var (
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDMediaAsset - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDMediaAsset - 2")
+		return conv.ErrBadParamInput
+	}
+
+	err = cs.mediaAssetService.DeleteByIDMediaAsset(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDMediaAsset - 3")
+		return err
+	}
+
+	resp.Meta.Message = "Success delete media asset"
+	resp.Meta.Status = true
+	resp.Data = nil
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// FetchOrphanMediaAsset implements MediaAssetHandlerInterface.
+func (cs *mediaAssetHandler) FetchOrphanMediaAsset(c echo.Context) error {
+	var (
+		resp            = response.DefaultSuccessResponse{}
+		ctx             = c.Request().Context()
+		respMediaAssets = []response.MediaAssetResponse{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchOrphanMediaAsset - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	results, err := cs.mediaAssetService.FetchOrphanMediaAsset(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchOrphanMediaAsset - 2")
+		return err
+	}
+
+	for _, val := range results {
+		respMediaAssets = append(respMediaAssets, toMediaAssetResponse(val))
+	}
+
+	resp.Meta.Message = "Success fetch orphan media asset"
+	resp.Meta.Status = true
+	resp.Data = respMediaAssets
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+func toMediaAssetResponse(val entity.MediaAssetEntity) response.MediaAssetResponse {
+	return response.MediaAssetResponse{
+		ID:          val.ID,
+		Path:        val.Path,
+		Url:         val.Url,
+		ContentType: val.ContentType,
+		Size:        val.Size,
+		Width:       val.Width,
+		Height:      val.Height,
+		UploaderID:  val.UploaderID,
+		AltText:     val.AltText,
+		Variants:    upload.ImageVariants(val.Url),
+		CreatedAt:   val.CreatedAt,
+	}
+}
+
+func NewMediaAssetHandler(e *echo.Echo, mediaAssetService service.MediaAssetServiceInterface, cfg *config.Config) MediaAssetHandlerInterface {
+	h := &mediaAssetHandler{
+		mediaAssetService: mediaAssetService,
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	mediaAssetApp := e.Group("/media-assets")
+	adminApp := mediaAssetApp.Group("/admin", mid.CheckToken())
+
+	adminApp.GET("", h.FetchAllMediaAsset)
+	adminApp.GET("/orphans", h.FetchOrphanMediaAsset)
+	adminApp.GET("/:id", h.FetchByIDMediaAsset)
+	adminApp.GET("/:id/references", h.FetchMediaAssetReference)
+	adminApp.PUT("/:id", h.EditAltTextMediaAsset)
+	adminApp.DELETE("/:id", h.DeleteByIDMediaAsset)
+
+	return h
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"latihan-compro/config"
+	"latihan-compro/utils/metrics"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type MetricsHandlerInterface interface {
+	FetchMetrics(c echo.Context) error
+}
+
+type metricsHandler struct {
+	token   string
+	metrics http.Handler
+}
+
+// FetchMetrics implements MetricsHandlerInterface.
+// With METRICS_TOKEN set the scraper must send it as a bearer token.
+func (m *metricsHandler) FetchMetrics(c echo.Context) error {
+	if m.token != "" && !hasBearer(c, m.token) {
+		return echo.ErrUnauthorized
+	}
+
+	m.metrics.ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
+// hasBearer reports whether the request sends token as a bearer token.
+func hasBearer(c echo.Context, token string) bool {
+	auth := c.Request().Header.Get(echo.HeaderAuthorization)
+	return subtle.ConstantTimeCompare([]byte(auth), []byte("Bearer "+token)) == 1
+}
+
+func NewMetricsHandler(e *echo.Echo, cfg *config.Config) MetricsHandlerInterface {
+	h := &metricsHandler{
+		token:   cfg.Metrics.Token,
+		metrics: metrics.Handler(),
+	}
+
+	e.GET("/metrics", h.FetchMetrics)
+
+	return h
+}
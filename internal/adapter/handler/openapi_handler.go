@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/utils/openapi"
+	"net/http"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+type OpenApiHandlerInterface interface {
+	FetchOpenApi(c echo.Context) error
+	FetchDocs(c echo.Context) error
+	FetchDocsAsset(c echo.Context) error
+}
+
+type openApiHandler struct {
+	e    *echo.Echo
+	once sync.Once
+	doc  *openapi.Document
+}
+
+// FetchOpenApi implements OpenApiHandlerInterface.
+// The document is built on the first request, once every handler registered
+// its routes.
+func (o *openApiHandler) FetchOpenApi(c echo.Context) error {
+	o.once.Do(func() {
+		o.doc = BuildOpenApi(o.e)
+	})
+	return c.JSON(http.StatusOK, o.doc)
+}
+
+// FetchDocs implements OpenApiHandlerInterface.
+func (o *openApiHandler) FetchDocs(c echo.Context) error {
+	return c.HTMLBlob(http.StatusOK, openapi.DocsPage)
+}
+
+// FetchDocsAsset implements OpenApiHandlerInterface.
+func (o *openApiHandler) FetchDocsAsset(c echo.Context) error {
+	return echo.StaticDirectoryHandler(openapi.DocsAssets, false)(c)
+}
+
+// BuildOpenApi documents the routes registered on e with ApiRoutes.
+func BuildOpenApi(e *echo.Echo) *openapi.Document {
+	return openapi.Build(openapi.Info{
+		Title:       "Company Profile API",
+		Version:     "1.0.0",
+		Description: "Public content of the company profile site and its admin API.",
+	}, e.Routes(), ApiRoutes(), openapi.Envelope{
+		Meta:       response.Meta{},
+		Pagination: response.PaginationResponse{},
+		Error:      response.ErrorResponseDefault{},
+	})
+}
+
+func NewOpenApiHandler(e *echo.Echo) OpenApiHandlerInterface {
+	h := &openApiHandler{
+		e: e,
+	}
+
+	e.GET("/openapi.json", h.FetchOpenApi)
+	e.GET("/docs", h.FetchDocs)
+	e.GET("/docs/*", h.FetchDocsAsset)
+
+	return h
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"encoding/json"
+	"latihan-compro/config"
+	"latihan-compro/utils/openapi"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// newTestEcho registers every route like app.RunServer does, services are
+// nil as only the routes are needed.
+func newTestEcho() *echo.Echo {
+	e := echo.New()
+	RegisterRoutes(e, &config.Config{}, Services{})
+	return e
+}
+
+func TestOpenApiRoutes(t *testing.T) {
+	e := newTestEcho()
+
+	registered := map[string]bool{}
+	for _, route := range e.Routes() {
+		if !openapi.SkipRoute(route) {
+			registered[route.Method+" "+route.Path] = true
+		}
+	}
+
+	documented := map[string]bool{}
+	for _, route := range ApiRoutes() {
+		if documented[route.Key()] {
+			t.Errorf("route %s is documented twice", route.Key())
+		}
+		documented[route.Key()] = true
+	}
+
+	var undocumented, stale []string
+	for key := range registered {
+		if !documented[key] {
+			undocumented = append(undocumented, key)
+		}
+	}
+	for key := range documented {
+		if !registered[key] {
+			stale = append(stale, key)
+		}
+	}
+	sort.Strings(undocumented)
+	sort.Strings(stale)
+
+	for _, key := range undocumented {
+		t.Errorf("route %s is registered but missing from ApiRoutes", key)
+	}
+	for _, key := range stale {
+		t.Errorf("route %s is in ApiRoutes but not registered", key)
+	}
+}
+
+func TestOpenApiDocument(t *testing.T) {
+	e := newTestEcho()
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /openapi.json returned %d", rec.Code)
+	}
+
+	var doc openapi.Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decoding document: %v", err)
+	}
+
+	operations := 0
+	operationIDs := map[string]string{}
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			operations++
+			if other, ok := operationIDs[op.OperationID]; ok {
+				t.Errorf("operationId %s of %s %s is also used by %s", op.OperationID, method, path, other)
+			}
+			operationIDs[op.OperationID] = method + " " + path
+		}
+	}
+	if operations != len(ApiRoutes()) {
+		t.Errorf("document has %d operations, ApiRoutes documents %d", operations, len(ApiRoutes()))
+	}
+
+	hero, ok := doc.Components.Schemas["HeroSectionRequest"]
+	if !ok {
+		t.Fatal("HeroSectionRequest schema is missing")
+	}
+	if _, ok := hero.Properties["subheading"]; !ok {
+		t.Errorf("HeroSectionRequest properties are %v, want the json names", hero.Properties)
+	}
+}
+
+func TestDocsAssets(t *testing.T) {
+	e := newTestEcho()
+
+	for path, contentType := range map[string]string{
+		"/docs":                      "text/html",
+		"/docs/swagger-ui.css":       "text/css",
+		"/docs/swagger-ui-bundle.js": "javascript",
+		"/docs/docs.js":              "javascript",
+	} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusOK || !strings.Contains(rec.Header().Get(echo.HeaderContentType), contentType) {
+			t.Errorf("GET %s = %d %s, want 200 %s", path, rec.Code, rec.Header().Get(echo.HeaderContentType), contentType)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/docs/index.html", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GET /docs/index.html = %d, want 404 as only the assets of the page are served", rec.Code)
+	}
+}
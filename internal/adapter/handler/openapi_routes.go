@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"latihan-compro/internal/adapter/handler/request"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/health"
+	"latihan-compro/utils/openapi"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	langParam = openapi.Param{Name: "lang", In: "query", Description: "Content locale, Accept-Language is used when unset"}
+
+	pageParams = []openapi.Param{
+		{Name: "page", In: "query", Type: "integer", Description: "Page number, 1 by default"},
+		{Name: "per_page", In: "query", Type: "integer", Description: "Page size, 20 by default and at most 100"},
+	}
+
+	tusResumableParam = openapi.Param{Name: headerTusResumable, In: "header", Required: true, Description: "Must be " + tusVersion}
+)
+
+// ApiRoutes documents every route registered by the handlers, the OpenAPI
+// document is built from it. Add the route here when registering a new one,
+// TestOpenApiRoutes fails until both match.
+func ApiRoutes() []openapi.Route {
+	routes := []openapi.Route{
+		{Method: http.MethodGet, Path: "/api/check", Tag: "system", Summary: "Liveness check", RawResponse: echo.MIMETextPlain},
+		{Method: http.MethodGet, Path: "/openapi.json", Tag: "system", Summary: "This OpenAPI document", RawResponse: echo.MIMEApplicationJSON},
+		{Method: http.MethodGet, Path: "/docs", Tag: "system", Summary: "Interactive API documentation", RawResponse: echo.MIMETextHTML},
+		{Method: http.MethodGet, Path: "/healthz", Tag: "system", Summary: "Liveness, the process is running", Unwrapped: true, Data: health.Report{}},
+		{Method: http.MethodGet, Path: "/readyz", Tag: "system", Summary: "Readiness with the status and latency of every dependency, 503 when one required is down. Errors are only shown with the METRICS_TOKEN bearer", Unwrapped: true, Data: health.Report{}},
+		{Method: http.MethodGet, Path: "/metrics", Tag: "system", Summary: "Prometheus metrics, behind METRICS_TOKEN when set", RawResponse: echo.MIMETextPlain},
+
+		{Method: http.MethodPost, Path: "/login", Tag: "auth", Summary: "Log in as admin", Body: request.LoginRequest{}, Data: response.LoginResponse{}},
+
+		{Method: http.MethodGet, Path: "/site", Tag: "site", Summary: "Every public home page section in one response", Params: []openapi.Param{
+			langParam,
+			{Name: "include", In: "query", Description: "Comma separated sections to return, all by default"},
+		}, Data: response.SiteResponse{}},
+
+		{Method: http.MethodGet, Path: "/hero-sections", Tag: entity.ContentTypeHeroSection, Summary: "Hero section of the home page", Params: []openapi.Param{langParam}, Data: response.HeroSectionResponse{}},
+		{Method: http.MethodGet, Path: "/client-sections", Tag: entity.ContentTypeClientSection, Summary: "Clients of the home page", Data: []response.ClientSectionResponse{}},
+		{Method: http.MethodGet, Path: "/about-company", Tag: entity.ContentTypeAboutCompany, Summary: "About the company with its keynotes", Params: []openapi.Param{langParam}, Data: response.AboutCompanyResponse{}},
+		{Method: http.MethodGet, Path: "/service-sections", Tag: entity.ContentTypeServiceSection, Summary: "Services of the home page", Params: []openapi.Param{langParam}, Data: []response.ServiceSectionResponse{}},
+		{Method: http.MethodGet, Path: "/service-details", Tag: entity.ContentTypeServiceDetail, Summary: "Detail of a service, documents are signed download urls", Params: []openapi.Param{
+			{Name: "service_id", In: "query", Type: "integer", Required: true, Description: "Service the detail belongs to"},
+			langParam,
+		}, Data: response.ServiceDetailResponse{}},
+		{Method: http.MethodGet, Path: "/service-details/:id/download/:type", Tag: entity.ContentTypeServiceDetail, Summary: "Download a service detail document, type is pdf or docx", Params: []openapi.Param{
+			{Name: "expires", In: "query", Required: true, Description: "Expiry of the signed url"},
+			{Name: "signature", In: "query", Required: true, Description: "Signature of the signed url"},
+		}, RawResponse: "application/octet-stream"},
+		{Method: http.MethodGet, Path: "/portofolio-sections", Tag: entity.ContentTypePortofolioSection, Summary: "Portofolios of the home page", Params: []openapi.Param{langParam}, Data: []response.PortofolioSectionResponse{}},
+		{Method: http.MethodGet, Path: "/portofolio-details/:id", Tag: entity.ContentTypePortofolioDetail, Summary: "Detail of a portofolio by portofolio section id", Params: []openapi.Param{langParam}, Data: response.PortofolioDetailResponse{}},
+		{Method: http.MethodGet, Path: "/portofolio-testimonials", Tag: entity.ContentTypePortofolioTestimonial, Summary: "Testimonials of the home page", Params: []openapi.Param{langParam}, Data: []response.PortofolioTestimonialResponse{}},
+		{Method: http.MethodGet, Path: "/our-teams", Tag: entity.ContentTypeOurTeam, Summary: "Team members of the home page", Params: []openapi.Param{langParam}, Data: []response.OurTeamResponse{}},
+		{Method: http.MethodGet, Path: "/faq-sections", Tag: entity.ContentTypeFaqSection, Summary: "FAQ of the home page", Params: []openapi.Param{langParam}, Data: []response.FaqSectionResponse{}},
+		{Method: http.MethodGet, Path: "/contact-us", Tag: entity.ContentTypeContactUs, Summary: "Contact of the home page", Params: []openapi.Param{langParam}, Data: response.ContactUsResponse{}},
+		{Method: http.MethodPost, Path: "/appointments", Tag: entity.ContentTypeAppointment, Summary: "Book an appointment", Body: request.AppointmentRequest{}, Status: http.StatusCreated},
+
+		{Method: http.MethodGet, Path: "/about-company-keynotes/admin/keynotes/:id", Tag: entity.ContentTypeAboutCompanyKeynote, Summary: "List keynotes of an about company", Auth: true, Data: []response.AboutCompanyKeynoteResponse{}},
+		{Method: http.MethodGet, Path: "/service-details/admin/:id/downloads", Tag: entity.ContentTypeServiceDetail, Summary: "Download counts of a service detail", Auth: true, Data: []response.ServiceDetailDownloadResponse{}},
+
+		{Method: http.MethodGet, Path: "/appointments/admin", Tag: entity.ContentTypeAppointment, Summary: "List appointments", Auth: true, Data: []response.AppointmentResponse{}},
+		{Method: http.MethodGet, Path: "/appointments/admin/:id", Tag: entity.ContentTypeAppointment, Summary: "Fetch an appointment", Auth: true, Data: response.AppointmentResponse{}},
+		{Method: http.MethodDelete, Path: "/appointments/admin/:id", Tag: entity.ContentTypeAppointment, Summary: "Delete an appointment", Auth: true},
+
+		{Method: http.MethodGet, Path: "/media-assets/admin", Tag: "media-assets", Summary: "List media assets", Auth: true, Params: append([]openapi.Param{
+			{Name: "search", In: "query", Description: "Matches the file name and alt text"},
+			{Name: "content_type", In: "query", Description: "MIME type or a prefix such as image/"},
+		}, pageParams...), Data: []response.MediaAssetResponse{}, Paginated: true},
+		{Method: http.MethodGet, Path: "/media-assets/admin/orphans", Tag: "media-assets", Summary: "List media assets not referenced by any content", Auth: true, Data: []response.MediaAssetResponse{}},
+		{Method: http.MethodGet, Path: "/media-assets/admin/:id", Tag: "media-assets", Summary: "Fetch a media asset", Auth: true, Data: response.MediaAssetResponse{}},
+		{Method: http.MethodGet, Path: "/media-assets/admin/:id/references", Tag: "media-assets", Summary: "List content referencing a media asset", Auth: true, Data: []response.MediaAssetReferenceResponse{}},
+		{Method: http.MethodPut, Path: "/media-assets/admin/:id", Tag: "media-assets", Summary: "Edit the alt text of a media asset", Auth: true, Body: request.MediaAssetRequest{}},
+		{Method: http.MethodDelete, Path: "/media-assets/admin/:id", Tag: "media-assets", Summary: "Delete a media asset not referenced by any content", Auth: true},
+
+		{Method: http.MethodPost, Path: "/upload-image", Tag: "uploads", Summary: "Upload an image to the media library", Auth: true, Form: []openapi.FormField{
+			{Name: "file", File: true, Required: true},
+			{Name: "alt_text"},
+		}, Data: response.UploadImageResponse{}, Status: http.StatusCreated},
+		{Method: http.MethodPost, Path: "/upload-document", Tag: "uploads", Summary: "Upload a service detail document", Auth: true, Form: []openapi.FormField{
+			{Name: "file", File: true, Required: true},
+		}, Data: response.UploadDocumentResponse{}, Status: http.StatusCreated},
+		{Method: http.MethodPost, Path: "/upload-presigned", Tag: "uploads", Summary: "Presign a direct upload to storage", Auth: true, Body: request.PresignedUploadRequest{}, Data: response.PresignedUploadResponse{}},
+		{Method: http.MethodPost, Path: "/upload-presigned/complete", Tag: "uploads", Summary: "Register a presigned upload in the media library", Auth: true, Body: request.CompletePresignedUploadRequest{}, Data: response.MediaAssetResponse{}, Status: http.StatusCreated},
+
+		{Method: http.MethodOptions, Path: "/upload-video", Tag: "uploads", Summary: "tus server capabilities", NoContent: true, Status: http.StatusNoContent},
+		{Method: http.MethodPost, Path: "/upload-video", Tag: "uploads", Summary: "Create a resumable video upload", Auth: true, Params: []openapi.Param{
+			tusResumableParam,
+			{Name: headerUploadLength, In: "header", Required: true, Type: "integer", Description: "Size of the whole video in bytes"},
+			{Name: headerUploadMetadata, In: "header", Description: "tus metadata, filename and filetype are read"},
+		}, Data: response.ChunkedUploadResponse{}, Status: http.StatusCreated},
+		{Method: http.MethodHead, Path: "/upload-video/:id", Tag: "uploads", Summary: "Offset of a resumable upload", Auth: true, Params: []openapi.Param{tusResumableParam}, NoContent: true},
+		{Method: http.MethodGet, Path: "/upload-video/:id", Tag: "uploads", Summary: "Fetch a resumable upload", Auth: true, Params: []openapi.Param{tusResumableParam}, Data: response.ChunkedUploadResponse{}},
+		{Method: http.MethodPatch, Path: "/upload-video/:id", Tag: "uploads", Summary: "Append a chunk to a resumable upload", Auth: true, Params: []openapi.Param{
+			tusResumableParam,
+			{Name: headerUploadOffset, In: "header", Required: true, Type: "integer", Description: "Offset the chunk starts at"},
+		}, RawBody: mimeOffsetOctetStream, NoContent: true, Status: http.StatusNoContent},
+		{Method: http.MethodDelete, Path: "/upload-video/:id", Tag: "uploads", Summary: "Abort a resumable upload", Auth: true, Params: []openapi.Param{tusResumableParam}, NoContent: true, Status: http.StatusNoContent},
+
+		{Method: http.MethodGet, Path: "/revisions/admin/:type/:id", Tag: "revisions", Summary: "List revisions of a content", Auth: true, Data: []response.ContentRevisionResponse{}},
+		{Method: http.MethodGet, Path: "/revisions/admin/:type/:id/diff", Tag: "revisions", Summary: "Diff two revisions of a content", Auth: true, Params: []openapi.Param{
+			{Name: "from", In: "query", Required: true, Type: "integer", Description: "Revision version"},
+			{Name: "to", In: "query", Required: true, Type: "integer", Description: "Revision version"},
+		}, Data: []response.ContentRevisionDiffResponse{}},
+		{Method: http.MethodPost, Path: "/revisions/admin/:type/:id/:version/restore", Tag: "revisions", Summary: "Restore a content to a revision", Auth: true},
+
+		{Method: http.MethodGet, Path: "/translations/admin/missing", Tag: "translations", Summary: "List content missing a translation", Auth: true, Params: []openapi.Param{
+			{Name: "locale", In: "query", Description: "Every supported locale but the default one when unset"},
+		}, Data: []response.MissingTranslationResponse{}},
+		{Method: http.MethodGet, Path: "/translations/admin/:type/:id", Tag: "translations", Summary: "List translations of a content", Auth: true, Data: []response.ContentTranslationResponse{}},
+		{Method: http.MethodPut, Path: "/translations/admin/:type/:id/:locale", Tag: "translations", Summary: "Create or replace a translation", Auth: true, Body: request.ContentTranslationRequest{}},
+		{Method: http.MethodDelete, Path: "/translations/admin/:type/:id/:locale", Tag: "translations", Summary: "Delete a translation", Auth: true},
+
+		{Method: http.MethodGet, Path: "/audit-logs/admin", Tag: "audit-logs", Summary: "List audit logs", Auth: true, Params: append([]openapi.Param{
+			{Name: "action", In: "query"},
+			{Name: "entity_type", In: "query"},
+			{Name: "entity_id", In: "query", Type: "integer"},
+			{Name: "actor_id", In: "query", Type: "integer"},
+			{Name: "from", In: "query", Description: "Date (YYYY-MM-DD) or RFC3339 time"},
+			{Name: "to", In: "query", Description: "Date (YYYY-MM-DD) or RFC3339 time"},
+		}, pageParams...), Data: []response.AuditLogResponse{}, Paginated: true},
+	}
+
+	routes = append(routes, adminCrudRoutes(entity.ContentTypeHeroSection, "hero section", request.HeroSectionRequest{}, []response.HeroSectionResponse{}, response.HeroSectionResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypeClientSection, "client section", request.ClientSectionRequest{}, []response.ClientSectionResponse{}, response.ClientSectionResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypeAboutCompany, "about company", request.AboutCompanyRequest{}, []response.AboutCompanyResponse{}, response.AboutCompanyResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypeAboutCompanyKeynote, "about company keynote", request.AboutCompanyKeynoteRequest{}, []response.AboutCompanyKeynoteResponse{}, response.AboutCompanyKeynoteResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypeFaqSection, "FAQ", request.FaqSectionRequest{}, []response.FaqSectionResponse{}, response.FaqSectionResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypeOurTeam, "team member", request.OurTeamRequest{}, []response.OurTeamResponse{}, response.OurTeamResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypeServiceSection, "service section", request.ServiceSectionRequest{}, []response.ServiceSectionResponse{}, response.ServiceSectionResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypeServiceDetail, "service detail", request.ServiceDetailRequest{}, []response.ServiceDetailResponse{}, response.ServiceDetailResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypePortofolioSection, "portofolio section", request.PortofolioSectionRequest{}, []response.PortofolioSectionResponse{}, response.PortofolioSectionResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypePortofolioDetail, "portofolio detail", request.PortofolioDetailRequest{}, []response.PortofolioDetailResponse{}, response.PortofolioDetailResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypePortofolioTestimonial, "portofolio testimonial", request.PortofolioTestimonialRequest{}, []response.PortofolioTestimonialResponse{}, response.PortofolioTestimonialResponse{})...)
+	routes = append(routes, adminCrudRoutes(entity.ContentTypeContactUs, "contact", request.ContactUsRequest{}, []response.ContactUsResponse{}, response.ContactUsResponse{})...)
+
+	for _, contentType := range entity.ContentTypes {
+		routes = append(routes,
+			openapi.Route{Method: http.MethodGet, Path: "/" + contentType + "/admin/trash", Tag: contentType, Summary: "List deleted " + contentType, Auth: true, Data: []response.TrashResponse{}},
+			openapi.Route{Method: http.MethodPost, Path: "/" + contentType + "/admin/trash/:id/restore", Tag: contentType, Summary: "Restore a deleted " + contentType + " row", Auth: true},
+			openapi.Route{Method: http.MethodDelete, Path: "/" + contentType + "/admin/trash/:id", Tag: contentType, Summary: "Permanently delete a deleted " + contentType + " row", Auth: true},
+		)
+	}
+
+	for _, contentType := range entity.SortableContentTypes {
+		routes = append(routes, openapi.Route{Method: http.MethodPut, Path: "/" + contentType + "/admin/reorder", Tag: contentType, Summary: "Reorder " + contentType, Auth: true, Body: request.ReorderRequest{}})
+	}
+
+	return routes
+}
+
+// adminCrudRoutes documents the admin routes every content group registers.
+func adminCrudRoutes(group, name string, body, list, item any) []openapi.Route {
+	prefix := "/" + group + "/admin"
+	return []openapi.Route{
+		{Method: http.MethodPost, Path: prefix, Tag: group, Summary: "Create " + name, Auth: true, Body: body, Status: http.StatusCreated},
+		{Method: http.MethodGet, Path: prefix, Tag: group, Summary: "List every " + name, Auth: true, Data: list},
+		{Method: http.MethodGet, Path: prefix + "/:id", Tag: group, Summary: "Fetch " + name, Auth: true, Data: item},
+		{Method: http.MethodPut, Path: prefix + "/:id", Tag: group, Summary: "Edit " + name, Auth: true, Body: body},
+		{Method: http.MethodDelete, Path: prefix + "/:id", Tag: group, Summary: "Delete " + name, Auth: true},
+	}
+}
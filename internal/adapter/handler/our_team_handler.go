@@ -8,10 +8,11 @@ import (
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type OurTeamHandlerInterface interface {
@@ -33,25 +34,24 @@ func (h *ourTeamHandler) FetchAllOurTeamHome(c echo.Context) error {
 	var (
 		respOurTeams = []response.OurTeamResponse{}
 		resp         = response.DefaultSuccessResponse{}
-		respError    = response.ErrorResponseDefault{}
 		ctx          = c.Request().Context()
 	)
 
 	results, err := h.ourTeamService.FetchAllOurTeam(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllOurTeamHome - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllOurTeamHome - 1")
+		return err
 	}
 
 	for _, val := range results {
 		respOurTeams = append(respOurTeams, response.OurTeamResponse{
-			ID:        val.ID,
-			Name:      val.Name,
-			Role:      val.Role,
-			PathPhoto: val.PathPhoto,
-			Tagline:   val.Tagline,
+			ID:            val.ID,
+			Position:      val.Position,
+			Name:          val.Name,
+			Role:          val.Role,
+			PathPhoto:     val.PathPhoto,
+			PhotoVariants: upload.ImageVariants(val.PathPhoto),
+			Tagline:       val.Tagline,
 		})
 	}
 
@@ -66,32 +66,25 @@ func (h *ourTeamHandler) FetchAllOurTeamHome(c echo.Context) error {
 // CreateOurTeam implements OurTeamHandlerInterface.
 func (h *ourTeamHandler) CreateOurTeam(c echo.Context) error {
 	var (
-		req       = request.OurTeamRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.OurTeamRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreateOurTeam - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateOurTeam - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateOurTeam - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateOurTeam - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateOurTeam - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateOurTeam - 3")
+		return err
 	}
 
 	reqEntity := entity.OurTeamEntity{
@@ -101,12 +94,10 @@ func (h *ourTeamHandler) CreateOurTeam(c echo.Context) error {
 		Tagline:   req.Tagline,
 	}
 
-	err = h.ourTeamService.CreateOurTeam(ctx, reqEntity)
+	err := h.ourTeamService.CreateOurTeam(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateOurTeam - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateOurTeam - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create our team"
@@ -119,34 +110,27 @@ func (h *ourTeamHandler) CreateOurTeam(c echo.Context) error {
 // DeleteByIDOurTeam implements OurTeamHandlerInterface.
 func (h *ourTeamHandler) DeleteByIDOurTeam(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDOurTeam - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDOurTeam - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idOurTeam := c.Param("id")
 	id, err := conv.StringToInt64(idOurTeam)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDOurTeam - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDOurTeam - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = h.ourTeamService.DeleteByIDOurTeam(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDOurTeam - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDOurTeam - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete our team"
 	resp.Meta.Status = true
@@ -159,41 +143,32 @@ func (h *ourTeamHandler) DeleteByIDOurTeam(c echo.Context) error {
 // EditByIDOurTeam implements OurTeamHandlerInterface.
 func (h *ourTeamHandler) EditByIDOurTeam(c echo.Context) error {
 	var (
-		req       = request.OurTeamRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.OurTeamRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDOurTeam - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDOurTeam - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idOurTeam := c.Param("id")
 	id, err := conv.StringToInt64(idOurTeam)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDOurTeam - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDOurTeam - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDOurTeam - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDOurTeam - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDOurTeam - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDOurTeam - 4")
+		return err
 	}
 
 	reqEntity := entity.OurTeamEntity{
@@ -206,10 +181,8 @@ func (h *ourTeamHandler) EditByIDOurTeam(c echo.Context) error {
 
 	err = h.ourTeamService.EditByIDOurTeam(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDOurTeam - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDOurTeam - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit our team"
 	resp.Meta.Status = true
@@ -222,34 +195,31 @@ func (h *ourTeamHandler) EditByIDOurTeam(c echo.Context) error {
 func (h *ourTeamHandler) FetchAllOurTeam(c echo.Context) error {
 	var (
 		resp        = response.DefaultSuccessResponse{}
-		respError   = response.ErrorResponseDefault{}
 		ctx         = c.Request().Context()
 		respOurTeam = []response.OurTeamResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllOurTeam - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllOurTeam - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := h.ourTeamService.FetchAllOurTeam(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllOurTeam - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllOurTeam - 2")
+		return err
 	}
 
 	for _, val := range results {
 		respOurTeam = append(respOurTeam, response.OurTeamResponse{
-			ID:        val.ID,
-			Name:      val.Name,
-			Role:      val.Role,
-			PathPhoto: val.PathPhoto,
-			Tagline:   val.Tagline,
+			ID:            val.ID,
+			Position:      val.Position,
+			Name:          val.Name,
+			Role:          val.Role,
+			PathPhoto:     val.PathPhoto,
+			PhotoVariants: upload.ImageVariants(val.PathPhoto),
+			Tagline:       val.Tagline,
 		})
 	}
 
@@ -264,40 +234,35 @@ func (h *ourTeamHandler) FetchAllOurTeam(c echo.Context) error {
 func (h *ourTeamHandler) FetchByIDOurTeam(c echo.Context) error {
 	var (
 		resp        = response.DefaultSuccessResponse{}
-		respError   = response.ErrorResponseDefault{}
 		ctx         = c.Request().Context()
 		respOurTeam = response.OurTeamResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDOurTeam - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDOurTeam - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idOurTeam := c.Param("id")
 	id, err := conv.StringToInt64(idOurTeam)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDOurTeam - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDOurTeam - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := h.ourTeamService.FetchByIDOurTeam(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDOurTeam - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDOurTeam - 3")
+		return err
 	}
 
 	respOurTeam.ID = result.ID
+	respOurTeam.Position = result.Position
 	respOurTeam.Name = result.Name
 	respOurTeam.Role = result.Role
 	respOurTeam.PathPhoto = result.PathPhoto
+	respOurTeam.PhotoVariants = upload.ImageVariants(result.PathPhoto)
 	respOurTeam.Tagline = result.Tagline
 	resp.Meta.Message = "Success fetch our team by ID"
 	resp.Meta.Status = true
@@ -314,7 +279,7 @@ func NewOurTeamHandler(c *echo.Echo, cfg *config.Config, ourTeamService service.
 	mid := middleware.NewMiddleware(cfg)
 
 	ourTeamApp := c.Group("/our-teams")
-	ourTeamApp.GET("", heroHandler.FetchAllOurTeamHome)
+	ourTeamApp.GET("", heroHandler.FetchAllOurTeamHome, mid.Locale())
 
 	adminApp := ourTeamApp.Group("/admin", mid.CheckToken())
 	adminApp.GET("", heroHandler.FetchAllOurTeam)
@@ -5,14 +5,16 @@ import (
 	"latihan-compro/internal/adapter/handler/request"
 	"latihan-compro/internal/adapter/handler/response"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type PortofolioDetailHandlerInterface interface {
@@ -31,40 +33,31 @@ type portofolioDetailHandler struct {
 // CreatePortofolioDetail implements PortofolioDetailHandlerInterface.
 func (cs *portofolioDetailHandler) CreatePortofolioDetail(c echo.Context) error {
 	var (
-		req       = request.PortofolioDetailRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.PortofolioDetailRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreatePortofolioDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreatePortofolioDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioDetail - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioDetail - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioDetail - 3")
+		return err
 	}
 
 	stringProjectDate, err := time.Parse("2006-01-02", req.ProjectDate)
 	if err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioDetail - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioDetail - 4")
+		return errs.Wrap(err, errs.KindValidation, "invalid_date", "project_date must be a date (YYYY-MM-DD)")
 	}
 	reqEntity := entity.PortofolioDetailEntity{
 		Category:    req.Category,
@@ -80,10 +73,8 @@ func (cs *portofolioDetailHandler) CreatePortofolioDetail(c echo.Context) error
 
 	err = cs.portofolioDetailService.CreatePortofolioDetail(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioDetail - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioDetail - 5")
+		return err
 	}
 
 	resp.Meta.Message = "Success create portofolio detail"
@@ -97,25 +88,20 @@ func (cs *portofolioDetailHandler) CreatePortofolioDetail(c echo.Context) error
 func (cs *portofolioDetailHandler) FetchAllPortofolioDetail(c echo.Context) error {
 	var (
 		resp                 = response.DefaultSuccessResponse{}
-		respError            = response.ErrorResponseDefault{}
 		ctx                  = c.Request().Context()
 		respPortofolioDetail = []response.PortofolioDetailResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllPortofolioDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllPortofolioDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.portofolioDetailService.FetchAllPortofolioDetail(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllPortofolioDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllPortofolioDetail - 2")
+		return err
 	}
 
 	for _, val := range results {
@@ -128,9 +114,10 @@ func (cs *portofolioDetailHandler) FetchAllPortofolioDetail(c echo.Context) erro
 			Title:       val.Title,
 			Description: val.Description,
 			PortofolioSection: response.PortofolioSectionResponse{
-				ID:        val.PortofolioSection.ID,
-				Name:      val.PortofolioSection.Name,
-				Thumbnail: val.PortofolioSection.Thumbnail,
+				ID:                val.PortofolioSection.ID,
+				Name:              val.PortofolioSection.Name,
+				Thumbnail:         val.PortofolioSection.Thumbnail,
+				ThumbnailVariants: upload.ImageVariants(val.PortofolioSection.Thumbnail),
 			},
 		})
 	}
@@ -146,34 +133,27 @@ func (cs *portofolioDetailHandler) FetchAllPortofolioDetail(c echo.Context) erro
 func (cs *portofolioDetailHandler) FetchByIDPortofolioDetail(c echo.Context) error {
 	var (
 		resp                 = response.DefaultSuccessResponse{}
-		respError            = response.ErrorResponseDefault{}
 		ctx                  = c.Request().Context()
 		respPortofolioDetail = response.PortofolioDetailResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDPortofolioDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDPortofolioDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idPortofolioDetail := c.Param("id")
 	id, err := conv.StringToInt64(idPortofolioDetail)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDPortofolioDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDPortofolioDetail - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.portofolioDetailService.FetchByIDPortofolioDetail(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDPortofolioDetail - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDPortofolioDetail - 3")
+		return err
 	}
 
 	respPortofolioDetail.ID = result.ID
@@ -186,6 +166,7 @@ func (cs *portofolioDetailHandler) FetchByIDPortofolioDetail(c echo.Context) err
 	respPortofolioDetail.PortofolioSection.ID = result.PortofolioSection.ID
 	respPortofolioDetail.PortofolioSection.Name = result.PortofolioSection.Name
 	respPortofolioDetail.PortofolioSection.Thumbnail = result.PortofolioSection.Thumbnail
+	respPortofolioDetail.PortofolioSection.ThumbnailVariants = upload.ImageVariants(result.PortofolioSection.Thumbnail)
 
 	resp.Meta.Message = "Success fetch portofolio detail by ID"
 	resp.Meta.Status = true
@@ -197,49 +178,38 @@ func (cs *portofolioDetailHandler) FetchByIDPortofolioDetail(c echo.Context) err
 // EditByIDPortofolioDetail implements PortofolioDetailHandlerInterface.
 func (cs *portofolioDetailHandler) EditByIDPortofolioDetail(c echo.Context) error {
 	var (
-		req       = request.PortofolioDetailRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.PortofolioDetailRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDPortofolioDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDPortofolioDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idPortofolioDetail := c.Param("id")
 	id, err := conv.StringToInt64(idPortofolioDetail)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioDetail - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioDetail - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioDetail - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioDetail - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioDetail - 4")
+		return err
 	}
 
 	stringProjectDate, err := time.Parse("2006-01-02", req.ProjectDate)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioDetail - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioDetail - 5")
+		return errs.Wrap(err, errs.KindValidation, "invalid_date", "project_date must be a date (YYYY-MM-DD)")
 	}
 
 	reqEntity := entity.PortofolioDetailEntity{
@@ -257,10 +227,8 @@ func (cs *portofolioDetailHandler) EditByIDPortofolioDetail(c echo.Context) erro
 
 	err = cs.portofolioDetailService.EditByIDPortofolioDetail(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioDetail - 6: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioDetail - 6")
+		return err
 	}
 	resp.Meta.Message = "Success edit portofolio detail"
 	resp.Meta.Status = true
@@ -272,34 +240,27 @@ func (cs *portofolioDetailHandler) EditByIDPortofolioDetail(c echo.Context) erro
 // DeleteByIDPortofolioDetail implements PortofolioDetailHandlerInterface.
 func (cs *portofolioDetailHandler) DeleteByIDPortofolioDetail(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDPortofolioDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDPortofolioDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idPortofolioDetail := c.Param("id")
 	id, err := conv.StringToInt64(idPortofolioDetail)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDPortofolioDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDPortofolioDetail - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.portofolioDetailService.DeleteByIDPortofolioDetail(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDPortofolioDetail - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDPortofolioDetail - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete portofolio detail"
 	resp.Meta.Status = true
@@ -314,24 +275,19 @@ func (cs *portofolioDetailHandler) FetchDetailPotofolioByPortoID(c echo.Context)
 	var (
 		respDetail = response.PortofolioDetailResponse{}
 		resp       = response.DefaultSuccessResponse{}
-		respError  = response.ErrorResponseDefault{}
 		ctx        = c.Request().Context()
 	)
 	idPorto := c.Param("id")
 	id, err := conv.StringToInt64(idPorto)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchDetailPotofolioByPortoID - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchDetailPotofolioByPortoID - 1")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.portofolioDetailService.FetchDetailPotofolioByPortoID(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchDetailPotofolioByPortoID - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchDetailPotofolioByPortoID - 2")
+		return err
 	}
 	respDetail.ID = result.ID
 	respDetail.Category = result.Category
@@ -343,6 +299,7 @@ func (cs *portofolioDetailHandler) FetchDetailPotofolioByPortoID(c echo.Context)
 	respDetail.PortofolioSection.ID = result.PortofolioSection.ID
 	respDetail.PortofolioSection.Name = result.PortofolioSection.Name
 	respDetail.PortofolioSection.Thumbnail = result.PortofolioSection.Thumbnail
+	respDetail.PortofolioSection.ThumbnailVariants = upload.ImageVariants(result.PortofolioSection.Thumbnail)
 	resp.Meta.Message = "Success"
 	resp.Meta.Status = true
 	resp.Data = respDetail
@@ -358,7 +315,7 @@ func NewPortofolioDetailHandler(e *echo.Echo, portofolioDetailService service.Po
 
 	portofolioDetailApp := e.Group("/portofolio-details")
 
-	portofolioDetailApp.GET("/:id", h.FetchDetailPotofolioByPortoID)
+	portofolioDetailApp.GET("/:id", h.FetchDetailPotofolioByPortoID, mid.Locale())
 
 	adminApp := portofolioDetailApp.Group("/admin", mid.CheckToken())
 
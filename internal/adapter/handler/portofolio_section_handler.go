@@ -8,10 +8,11 @@ import (
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type PortofolioSectionHandlerInterface interface {
@@ -30,32 +31,25 @@ type portofolioSectionHandler struct {
 // CreatePortofolioSection implements PortofolioSectionHandlerInterface.
 func (cs *portofolioSectionHandler) CreatePortofolioSection(c echo.Context) error {
 	var (
-		req       = request.PortofolioSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.PortofolioSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreatePortofolioSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreatePortofolioSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioSection - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioSection - 3")
+		return err
 	}
 
 	reqEntity := entity.PortofolioSectionEntity{
@@ -64,12 +58,10 @@ func (cs *portofolioSectionHandler) CreatePortofolioSection(c echo.Context) erro
 		Tagline:   req.Tagline,
 	}
 
-	err = cs.portofolioSectionService.CreatePortofolioSection(ctx, reqEntity)
+	err := cs.portofolioSectionService.CreatePortofolioSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioSection - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create portofolio section"
@@ -83,33 +75,30 @@ func (cs *portofolioSectionHandler) CreatePortofolioSection(c echo.Context) erro
 func (cs *portofolioSectionHandler) FetchAllPortofolioSection(c echo.Context) error {
 	var (
 		resp                  = response.DefaultSuccessResponse{}
-		respError             = response.ErrorResponseDefault{}
 		ctx                   = c.Request().Context()
 		respPortofolioSection = []response.PortofolioSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllPortofolioSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllPortofolioSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.portofolioSectionService.FetchAllPortofolioSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllPortofolioSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllPortofolioSection - 2")
+		return err
 	}
 
 	for _, val := range results {
 		respPortofolioSection = append(respPortofolioSection, response.PortofolioSectionResponse{
-			ID:        val.ID,
-			Name:      val.Name,
-			Tagline:   val.Tagline,
-			Thumbnail: val.Thumbnail,
+			ID:                val.ID,
+			Position:          val.Position,
+			Name:              val.Name,
+			Tagline:           val.Tagline,
+			Thumbnail:         val.Thumbnail,
+			ThumbnailVariants: upload.ImageVariants(val.Thumbnail),
 		})
 	}
 
@@ -124,40 +113,35 @@ func (cs *portofolioSectionHandler) FetchAllPortofolioSection(c echo.Context) er
 func (cs *portofolioSectionHandler) FetchByIDPortofolioSection(c echo.Context) error {
 	var (
 		resp                  = response.DefaultSuccessResponse{}
-		respError             = response.ErrorResponseDefault{}
 		ctx                   = c.Request().Context()
 		respPortofolioSection = response.PortofolioSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDPortofolioSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDPortofolioSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idPortofolioSection := c.Param("id")
 	id, err := conv.StringToInt64(idPortofolioSection)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDPortofolioSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDPortofolioSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.portofolioSectionService.FetchByIDPortofolioSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDPortofolioSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDPortofolioSection - 3")
+		return err
 	}
 
 	respPortofolioSection.ID = result.ID
+	respPortofolioSection.Position = result.Position
 	respPortofolioSection.Name = result.Name
 	respPortofolioSection.Tagline = result.Tagline
 	respPortofolioSection.Thumbnail = result.Thumbnail
+	respPortofolioSection.ThumbnailVariants = upload.ImageVariants(result.Thumbnail)
 	resp.Meta.Message = "Success fetch portofolio section by ID"
 	resp.Meta.Status = true
 	resp.Data = respPortofolioSection
@@ -168,41 +152,32 @@ func (cs *portofolioSectionHandler) FetchByIDPortofolioSection(c echo.Context) e
 // EditByIDPortofolioSection implements PortofolioSectionHandlerInterface.
 func (cs *portofolioSectionHandler) EditByIDPortofolioSection(c echo.Context) error {
 	var (
-		req       = request.PortofolioSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.PortofolioSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDPortofolioSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDPortofolioSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idPortofolioSection := c.Param("id")
 	id, err := conv.StringToInt64(idPortofolioSection)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioSection - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioSection - 4")
+		return err
 	}
 
 	reqEntity := entity.PortofolioSectionEntity{
@@ -214,10 +189,8 @@ func (cs *portofolioSectionHandler) EditByIDPortofolioSection(c echo.Context) er
 
 	err = cs.portofolioSectionService.EditByIDPortofolioSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioSection - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioSection - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit portofolio section"
 	resp.Meta.Status = true
@@ -229,34 +202,27 @@ func (cs *portofolioSectionHandler) EditByIDPortofolioSection(c echo.Context) er
 // DeleteByIDPortofolioSection implements PortofolioSectionHandlerInterface.
 func (cs *portofolioSectionHandler) DeleteByIDPortofolioSection(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDPortofolioSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDPortofolioSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idPortofolioSection := c.Param("id")
 	id, err := conv.StringToInt64(idPortofolioSection)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDPortofolioSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDPortofolioSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.portofolioSectionService.DeleteByIDPortofolioSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDPortofolioSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDPortofolioSection - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete portofolio section"
 	resp.Meta.Status = true
@@ -271,23 +237,22 @@ func (cs *portofolioSectionHandler) FetchAllPortofolioHome(c echo.Context) error
 	var (
 		respPortofolios = []response.PortofolioSectionResponse{}
 		resp            = response.DefaultSuccessResponse{}
-		respError       = response.ErrorResponseDefault{}
 		ctx             = c.Request().Context()
 	)
 
 	results, err := cs.portofolioSectionService.FetchAllPortofolioSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllPortofolioHome - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllPortofolioHome - 1")
+		return err
 	}
 	for _, val := range results {
 		respPortofolios = append(respPortofolios, response.PortofolioSectionResponse{
-			ID:        val.ID,
-			Name:      val.Name,
-			Tagline:   val.Tagline,
-			Thumbnail: val.Thumbnail,
+			ID:                val.ID,
+			Position:          val.Position,
+			Name:              val.Name,
+			Tagline:           val.Tagline,
+			Thumbnail:         val.Thumbnail,
+			ThumbnailVariants: upload.ImageVariants(val.Thumbnail),
 		})
 	}
 	resp.Meta.Message = "Success fetch all portofolio home"
@@ -304,7 +269,7 @@ func NewPortofolioSectionHandler(e *echo.Echo, portofolioSectionService service.
 	mid := middleware.NewMiddleware(cfg)
 
 	portofolioSectionApp := e.Group("/portofolio-sections")
-	portofolioSectionApp.GET("", h.FetchAllPortofolioHome)
+	portofolioSectionApp.GET("", h.FetchAllPortofolioHome, mid.Locale())
 
 	adminApp := portofolioSectionApp.Group("/admin", mid.CheckToken())
 
@@ -8,10 +8,11 @@ import (
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type PortofolioTestimonialHandlerInterface interface {
@@ -30,32 +31,25 @@ type portofolioTestimonialHandler struct {
 // CreatePortofolioTestimonial implements PortofolioTestimonialHandlerInterface.
 func (cs *portofolioTestimonialHandler) CreatePortofolioTestimonial(c echo.Context) error {
 	var (
-		req       = request.PortofolioTestimonialRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.PortofolioTestimonialRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreatePortofolioTestimonial - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreatePortofolioTestimonial - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioTestimonial - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioTestimonial - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioTestimonial - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioTestimonial - 3")
+		return err
 	}
 
 	reqEntity := entity.PortofolioTestimonialEntity{
@@ -66,12 +60,10 @@ func (cs *portofolioTestimonialHandler) CreatePortofolioTestimonial(c echo.Conte
 		PortofolioSection: entity.PortofolioSectionEntity{ID: req.PortofolioSectionID},
 	}
 
-	err = cs.portofolioTestimonialService.CreatePortofolioTestimonial(ctx, reqEntity)
+	err := cs.portofolioTestimonialService.CreatePortofolioTestimonial(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreatePortofolioTestimonial - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreatePortofolioTestimonial - 5")
+		return err
 	}
 
 	resp.Meta.Message = "Success create portofolio testimonial"
@@ -85,31 +77,27 @@ func (cs *portofolioTestimonialHandler) CreatePortofolioTestimonial(c echo.Conte
 func (cs *portofolioTestimonialHandler) FetchAllPortofolioTestimonial(c echo.Context) error {
 	var (
 		resp                      = response.DefaultSuccessResponse{}
-		respError                 = response.ErrorResponseDefault{}
 		ctx                       = c.Request().Context()
 		respPortofolioTestimonial = []response.PortofolioTestimonialResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllPortofolioTestimonial - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllPortofolioTestimonial - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.portofolioTestimonialService.FetchAllPortofolioTestimonial(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllPortofolioTestimonial - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllPortofolioTestimonial - 2")
+		return err
 	}
 
 	for _, val := range results {
 		respPortofolioTestimonial = append(respPortofolioTestimonial, response.PortofolioTestimonialResponse{
 			ID:                val.ID,
 			Thumbnail:         val.Thumbnail,
+			ThumbnailVariants: upload.ImageVariants(val.Thumbnail),
 			Message:           val.Message,
 			ClientName:        val.ClientName,
 			Role:              val.Role,
@@ -128,44 +116,39 @@ func (cs *portofolioTestimonialHandler) FetchAllPortofolioTestimonial(c echo.Con
 func (cs *portofolioTestimonialHandler) FetchByIDPortofolioTestimonial(c echo.Context) error {
 	var (
 		resp                      = response.DefaultSuccessResponse{}
-		respError                 = response.ErrorResponseDefault{}
 		ctx                       = c.Request().Context()
 		respPortofolioTestimonial = response.PortofolioTestimonialResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDPortofolioTestimonial - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDPortofolioTestimonial - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idPortofolioTestimonial := c.Param("id")
 	id, err := conv.StringToInt64(idPortofolioTestimonial)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDPortofolioTestimonial - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDPortofolioTestimonial - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.portofolioTestimonialService.FetchByIDPortofolioTestimonial(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDPortofolioTestimonial - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDPortofolioTestimonial - 3")
+		return err
 	}
 
 	respPortofolioTestimonial.ID = result.ID
 	respPortofolioTestimonial.Thumbnail = result.Thumbnail
+	respPortofolioTestimonial.ThumbnailVariants = upload.ImageVariants(result.Thumbnail)
 	respPortofolioTestimonial.Message = result.Message
 	respPortofolioTestimonial.ClientName = result.ClientName
 	respPortofolioTestimonial.Role = result.Role
 	respPortofolioTestimonial.PortofolioSection.ID = result.PortofolioSection.ID
 	respPortofolioTestimonial.PortofolioSection.Name = result.PortofolioSection.Name
 	respPortofolioTestimonial.PortofolioSection.Thumbnail = result.PortofolioSection.Thumbnail
+	respPortofolioTestimonial.PortofolioSection.ThumbnailVariants = upload.ImageVariants(result.PortofolioSection.Thumbnail)
 
 	resp.Meta.Message = "Success fetch portofolio testimonial by ID"
 	resp.Meta.Status = true
@@ -177,41 +160,32 @@ func (cs *portofolioTestimonialHandler) FetchByIDPortofolioTestimonial(c echo.Co
 // EditByIDPortofolioTestimonial implements PortofolioTestimonialHandlerInterface.
 func (cs *portofolioTestimonialHandler) EditByIDPortofolioTestimonial(c echo.Context) error {
 	var (
-		req       = request.PortofolioTestimonialRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.PortofolioTestimonialRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDPortofolioTestimonial - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDPortofolioTestimonial - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idPortofolioTestimonial := c.Param("id")
 	id, err := conv.StringToInt64(idPortofolioTestimonial)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioTestimonial - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioTestimonial - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioTestimonial - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioTestimonial - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioTestimonial - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioTestimonial - 4")
+		return err
 	}
 
 	reqEntity := entity.PortofolioTestimonialEntity{
@@ -225,10 +199,8 @@ func (cs *portofolioTestimonialHandler) EditByIDPortofolioTestimonial(c echo.Con
 
 	err = cs.portofolioTestimonialService.EditByIDPortofolioTestimonial(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDPortofolioTestimonial - 6: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDPortofolioTestimonial - 6")
+		return err
 	}
 	resp.Meta.Message = "Success edit portofolio testimonial"
 	resp.Meta.Status = true
@@ -240,34 +212,27 @@ func (cs *portofolioTestimonialHandler) EditByIDPortofolioTestimonial(c echo.Con
 // DeleteByIDPortofolioTestimonial implements PortofolioTestimonialHandlerInterface.
 func (cs *portofolioTestimonialHandler) DeleteByIDPortofolioTestimonial(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDPortofolioTestimonial - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDPortofolioTestimonial - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idPortofolioTestimonial := c.Param("id")
 	id, err := conv.StringToInt64(idPortofolioTestimonial)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDPortofolioTestimonial - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDPortofolioTestimonial - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.portofolioTestimonialService.DeleteByIDPortofolioTestimonial(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDPortofolioTestimonial - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDPortofolioTestimonial - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete portofolio testimonial"
 	resp.Meta.Status = true
@@ -282,24 +247,22 @@ func (cs *portofolioTestimonialHandler) FetchAllPortofolioTestimonialHome(c echo
 	var (
 		respTestimonials = []response.PortofolioTestimonialResponse{}
 		resp             = response.DefaultSuccessResponse{}
-		respError        = response.ErrorResponseDefault{}
 		ctx              = c.Request().Context()
 	)
 
 	results, err := cs.portofolioTestimonialService.FetchAllPortofolioTestimonial(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllPortofolioTestimonialHome - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllPortofolioTestimonialHome - 1")
+		return err
 	}
 	for _, val := range results {
 		respTestimonials = append(respTestimonials, response.PortofolioTestimonialResponse{
-			ID:         val.ID,
-			Thumbnail:  val.Thumbnail,
-			Message:    val.Message,
-			ClientName: val.ClientName,
-			Role:       val.Role,
+			ID:                val.ID,
+			Thumbnail:         val.Thumbnail,
+			ThumbnailVariants: upload.ImageVariants(val.Thumbnail),
+			Message:           val.Message,
+			ClientName:        val.ClientName,
+			Role:              val.Role,
 			PortofolioSection: response.PortofolioSectionResponse{
 				Name: val.PortofolioSection.Name,
 			},
@@ -320,7 +283,7 @@ func NewPortofolioTestimonialHandler(e *echo.Echo, portofolioTestimonialService
 	mid := middleware.NewMiddleware(cfg)
 
 	portofolioTestimonialApp := e.Group("/portofolio-testimonials")
-	portofolioTestimonialApp.GET("", h.FetchAllPortofolioTestimonialHome)
+	portofolioTestimonialApp.GET("", h.FetchAllPortofolioTestimonialHome, mid.Locale())
 
 	adminApp := portofolioTestimonialApp.Group("/admin", mid.CheckToken())
 
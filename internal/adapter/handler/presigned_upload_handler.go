@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/request"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type PresignedUploadHandlerInterface interface {
+	PresignUpload(c echo.Context) error
+	CompletePresignedUpload(c echo.Context) error
+}
+
+type presignedUploadHandler struct {
+	mediaAssetService service.MediaAssetServiceInterface
+}
+
+// PresignUpload implements PresignedUploadHandlerInterface.
+func (p *presignedUploadHandler) PresignUpload(c echo.Context) error {
+	var (
+		req  = request.PresignedUploadRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] PresignUpload - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] PresignUpload - 2")
+		return bindError(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] PresignUpload - 3")
+		return err
+	}
+
+	result, err := p.mediaAssetService.PresignMediaAsset(ctx, req.ContentType, req.Size)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] PresignUpload - 4")
+		return uploadError(err)
+	}
+
+	resp.Meta.Message = "Success presign upload"
+	resp.Meta.Status = true
+	resp.Data = response.PresignedUploadResponse{
+		Method:    result.Method,
+		Url:       result.Url,
+		Fields:    result.Fields,
+		Headers:   result.Headers,
+		Path:      result.Path,
+		Expires:   result.Expires,
+		Signature: result.Signature,
+		ExpiresAt: result.ExpiresAt,
+	}
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CompletePresignedUpload implements PresignedUploadHandlerInterface.
+func (p *presignedUploadHandler) CompletePresignedUpload(c echo.Context) error {
+	var (
+		req  = request.CompletePresignedUploadRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] CompletePresignedUpload - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CompletePresignedUpload - 2")
+		return bindError(err)
+	}
+
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CompletePresignedUpload - 3")
+		return err
+	}
+
+	result, err := p.mediaAssetService.CompletePresignedMediaAsset(ctx, entity.CompletePresignedUploadEntity{
+		Path:      req.Path,
+		Expires:   req.Expires,
+		Signature: req.Signature,
+		AltText:   req.AltText,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CompletePresignedUpload - 4")
+		return uploadError(err)
+	}
+
+	resp.Meta.Message = "Success complete upload"
+	resp.Meta.Status = true
+	resp.Data = toMediaAssetResponse(*result)
+	resp.Pagination = nil
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func NewPresignedUploadHandler(e *echo.Echo, mediaAssetService service.MediaAssetServiceInterface, cfg *config.Config) PresignedUploadHandlerInterface {
+	h := &presignedUploadHandler{
+		mediaAssetService: mediaAssetService,
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	presignedApp := e.Group("/upload-presigned", mid.CheckToken())
+	presignedApp.POST("", h.PresignUpload)
+	presignedApp.POST("/complete", h.CompletePresignedUpload)
+
+	return h
+}
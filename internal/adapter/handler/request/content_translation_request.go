@@ -0,0 +1,5 @@
+package request
+
+type ContentTranslationRequest struct {
+	Fields map[string]string `json:"fields" validate:"required,min=1"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type MediaAssetRequest struct {
+	AltText string `json:"alt_text" validate:"max=255"`
+}
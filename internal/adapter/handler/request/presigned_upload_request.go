@@ -0,0 +1,13 @@
+package request
+
+type PresignedUploadRequest struct {
+	ContentType string `json:"content_type" validate:"required"`
+	Size        int64  `json:"size" validate:"required,gt=0"`
+}
+
+type CompletePresignedUploadRequest struct {
+	Path      string `json:"path" validate:"required"`
+	Expires   string `json:"expires" validate:"required"`
+	Signature string `json:"signature" validate:"required"`
+	AltText   string `json:"alt_text" validate:"max=255"`
+}
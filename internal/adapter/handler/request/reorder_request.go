@@ -0,0 +1,5 @@
+package request
+
+type ReorderRequest struct {
+	IDs []int64 `json:"ids" validate:"required,min=1,unique,dive,gt=0"`
+}
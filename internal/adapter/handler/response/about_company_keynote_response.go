@@ -1,9 +1,10 @@
 package response
 
 type AboutCompanyKeynoteResponse struct {
-	ID                      int64  `json:"id"`
-	AboutCompanyID          int64  `json:"about_company_id"`
-	Keynote                 string `json:"keynote"`
-	PathImage               string `json:"path_image"`
-	AboutCompanyDescription string `json:"about_company_description"`
+	ID                      int64                        `json:"id"`
+	AboutCompanyID          int64                        `json:"about_company_id"`
+	Keynote                 string                       `json:"keynote"`
+	PathImage               string                       `json:"path_image"`
+	ImageVariants           map[string]map[string]string `json:"image_variants,omitempty"`
+	AboutCompanyDescription string                       `json:"about_company_description"`
 }
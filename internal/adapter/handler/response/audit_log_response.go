@@ -0,0 +1,19 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditLogResponse struct {
+	ID          int64           `json:"id"`
+	ActorID     int64           `json:"actor_id"`
+	Action      string          `json:"action"`
+	EntityType  string          `json:"entity_type"`
+	EntityID    int64           `json:"entity_id"`
+	BeforeValue json.RawMessage `json:"before_value"`
+	AfterValue  json.RawMessage `json:"after_value"`
+	IpAddress   string          `json:"ip_address"`
+	UserAgent   string          `json:"user_agent"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
@@ -0,0 +1,15 @@
+package response
+
+import "time"
+
+type ChunkedUploadResponse struct {
+	ID           string    `json:"id"`
+	FileName     string    `json:"file_name"`
+	Length       int64     `json:"length"`
+	Offset       int64     `json:"offset"`
+	ContentType  string    `json:"content_type"`
+	Completed    bool      `json:"completed"`
+	MediaAssetID int64     `json:"media_asset_id,omitempty"`
+	Url          string    `json:"url,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -1,7 +1,9 @@
 package response
 
 type ClientSectionResponse struct {
-	ID       int64  `json:"id"`
-	Name     string `json:"name"`
-	PathIcon string `json:"path_icon"`
+	ID           int64                        `json:"id"`
+	Name         string                       `json:"name"`
+	PathIcon     string                       `json:"path_icon"`
+	IconVariants map[string]map[string]string `json:"icon_variants,omitempty"`
+	Position     int64                        `json:"position"`
 }
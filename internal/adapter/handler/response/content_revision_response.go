@@ -0,0 +1,23 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ContentRevisionResponse struct {
+	ID          int64           `json:"id"`
+	ContentType string          `json:"content_type"`
+	ContentID   int64           `json:"content_id"`
+	Version     int64           `json:"version"`
+	AuthorID    int64           `json:"author_id"`
+	Note        string          `json:"note"`
+	Snapshot    json.RawMessage `json:"snapshot"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+type ContentRevisionDiffResponse struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
@@ -0,0 +1,18 @@
+package response
+
+import "time"
+
+type ContentTranslationResponse struct {
+	ID        int64     `json:"id"`
+	Locale    string    `json:"locale"`
+	Field     string    `json:"field"`
+	Value     string    `json:"value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type MissingTranslationResponse struct {
+	ContentType string   `json:"content_type"`
+	ContentID   int64    `json:"content_id"`
+	Locale      string   `json:"locale"`
+	Fields      []string `json:"fields"`
+}
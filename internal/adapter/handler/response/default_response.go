@@ -2,6 +2,16 @@ package response
 
 type ErrorResponseDefault struct {
 	Meta
+	// Code is a stable machine readable identifier of the error.
+	Code string `json:"code,omitempty"`
+	// Errors lists the failed rules of a request that did not validate.
+	Errors []FieldErrorResponse `json:"errors,omitempty"`
+}
+
+type FieldErrorResponse struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
 }
 
 type Meta struct {
@@ -4,4 +4,5 @@ type FaqSectionResponse struct {
 	ID          int64  `json:"id"`
 	Title       string `json:"title"`
 	Description string `json:"description"`
+	Position    int64  `json:"position"`
 }
@@ -1,9 +1,10 @@
 package response
 
 type HeroSectionResponse struct {
-	ID         int64  `json:"id"`
-	Heading    string `json:"heading"`
-	SubHeading string `json:"subheading"`
-	PathVideo  string `json:"path_video"`
-	Banner     string `json:"banner"`
+	ID             int64                        `json:"id"`
+	Heading        string                       `json:"heading"`
+	SubHeading     string                       `json:"subheading"`
+	PathVideo      string                       `json:"path_video"`
+	Banner         string                       `json:"banner"`
+	BannerVariants map[string]map[string]string `json:"banner_variants,omitempty"`
 }
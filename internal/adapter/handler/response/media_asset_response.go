@@ -0,0 +1,23 @@
+package response
+
+import "time"
+
+type MediaAssetResponse struct {
+	ID          int64                        `json:"id"`
+	Path        string                       `json:"path"`
+	Url         string                       `json:"url"`
+	ContentType string                       `json:"content_type"`
+	Size        int64                        `json:"size"`
+	Width       int64                        `json:"width,omitempty"`
+	Height      int64                        `json:"height,omitempty"`
+	UploaderID  int64                        `json:"uploader_id,omitempty"`
+	AltText     string                       `json:"alt_text"`
+	Variants    map[string]map[string]string `json:"variants,omitempty"`
+	CreatedAt   time.Time                    `json:"created_at"`
+}
+
+type MediaAssetReferenceResponse struct {
+	ContentType string `json:"content_type"`
+	ContentID   int64  `json:"content_id"`
+	Field       string `json:"field"`
+}
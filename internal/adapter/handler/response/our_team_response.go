@@ -1,9 +1,11 @@
 package response
 
 type OurTeamResponse struct {
-	ID        int64  `json:"id"`
-	Name      string `json:"name"`
-	Role      string `json:"role"`
-	Tagline   string `json:"tagline"`
-	PathPhoto string `json:"path_photo"`
+	ID            int64                        `json:"id"`
+	Name          string                       `json:"name"`
+	Role          string                       `json:"role"`
+	Tagline       string                       `json:"tagline"`
+	PathPhoto     string                       `json:"path_photo"`
+	PhotoVariants map[string]map[string]string `json:"photo_variants,omitempty"`
+	Position      int64                        `json:"position"`
 }
@@ -1,8 +1,10 @@
 package response
 
 type PortofolioSectionResponse struct {
-	ID        int64  `json:"id"`
-	Thumbnail string `json:"thumbnail"`
-	Name      string `json:"name"`
-	Tagline   string `json:"tagline"`
+	ID                int64                        `json:"id"`
+	Thumbnail         string                       `json:"thumbnail"`
+	ThumbnailVariants map[string]map[string]string `json:"thumbnail_variants,omitempty"`
+	Name              string                       `json:"name"`
+	Tagline           string                       `json:"tagline"`
+	Position          int64                        `json:"position"`
 }
@@ -0,0 +1,14 @@
+package response
+
+import "time"
+
+type PresignedUploadResponse struct {
+	Method    string            `json:"method"`
+	Url       string            `json:"url"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Path      string            `json:"path"`
+	Expires   string            `json:"expires"`
+	Signature string            `json:"signature"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
@@ -1,12 +1,21 @@
 package response
 
+import "time"
+
 type ServiceDetailResponse struct {
-	ID          int64   `json:"id"`
-	ServiceID   int64   `json:"service_id"`
-	PathImage   string  `json:"path_image"`
-	Title       string  `json:"title"`
-	Description string  `json:"description"`
-	PathPdf     *string `json:"path_pdf"`
-	PathDocx    *string `json:"path_docx"`
-	ServiceName string  `json:"service_name"`
+	ID            int64                        `json:"id"`
+	ServiceID     int64                        `json:"service_id"`
+	PathImage     string                       `json:"path_image"`
+	ImageVariants map[string]map[string]string `json:"image_variants,omitempty"`
+	Title         string                       `json:"title"`
+	Description   string                       `json:"description"`
+	PathPdf       *string                      `json:"path_pdf"`
+	PathDocx      *string                      `json:"path_docx"`
+	ServiceName   string                       `json:"service_name"`
+}
+
+type ServiceDetailDownloadResponse struct {
+	FileType         string     `json:"file_type"`
+	Total            int64      `json:"total"`
+	LastDownloadedAt *time.Time `json:"last_downloaded_at"`
 }
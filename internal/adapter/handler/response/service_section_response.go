@@ -1,8 +1,10 @@
 package response
 
 type ServiceSectionResponse struct {
-	ID       int64  `json:"id"`
-	Name     string `json:"name"`
-	Tagline  string `json:"tagline"`
-	PathIcon string `json:"path_icon"`
+	ID           int64                        `json:"id"`
+	Name         string                       `json:"name"`
+	Tagline      string                       `json:"tagline"`
+	PathIcon     string                       `json:"path_icon"`
+	IconVariants map[string]map[string]string `json:"icon_variants,omitempty"`
+	Position     int64                        `json:"position"`
 }
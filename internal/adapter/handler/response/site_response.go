@@ -0,0 +1,16 @@
+package response
+
+// SiteResponse holds the requested sections, sections left out or failed are
+// omitted. Errors names every failed section with its error code.
+type SiteResponse struct {
+	HeroSection            *HeroSectionResponse            `json:"hero_section,omitempty"`
+	ClientSections         []ClientSectionResponse         `json:"client_sections,omitempty"`
+	AboutCompany           *AboutCompanyResponse           `json:"about_company,omitempty"`
+	ServiceSections        []ServiceSectionResponse        `json:"service_sections,omitempty"`
+	PortofolioSections     []PortofolioSectionResponse     `json:"portofolio_sections,omitempty"`
+	PortofolioTestimonials []PortofolioTestimonialResponse `json:"portofolio_testimonials,omitempty"`
+	OurTeams               []OurTeamResponse               `json:"our_teams,omitempty"`
+	FaqSections            []FaqSectionResponse            `json:"faq_sections,omitempty"`
+	ContactUs              *ContactUsResponse              `json:"contact_us,omitempty"`
+	Errors                 map[string]string               `json:"errors,omitempty"`
+}
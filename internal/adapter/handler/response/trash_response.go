@@ -0,0 +1,13 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type TrashResponse struct {
+	ContentType string          `json:"content_type"`
+	ID          int64           `json:"id"`
+	Data        json.RawMessage `json:"data"`
+	DeletedAt   time.Time       `json:"deleted_at"`
+}
@@ -0,0 +1,8 @@
+package response
+
+type UploadDocumentResponse struct {
+	ID          int64  `json:"id"`
+	Url         string `json:"url"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
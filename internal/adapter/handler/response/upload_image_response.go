@@ -0,0 +1,8 @@
+package response
+
+type UploadImageResponse struct {
+	ID          int64                        `json:"id"`
+	Url         string                       `json:"url"`
+	ContentType string                       `json:"content_type"`
+	Variants    map[string]map[string]string `json:"variants,omitempty"`
+}
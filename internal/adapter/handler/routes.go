@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"latihan-compro/config"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/health"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Services are the dependencies of the handlers registered by RegisterRoutes.
+type Services struct {
+	User                  service.UserServiceInterface
+	MediaAsset            service.MediaAssetServiceInterface
+	ChunkedUpload         service.ChunkedUploadServiceInterface
+	HeroSection           service.HeroSectionServiceInterface
+	ClientSection         service.ClientSectionServiceInterface
+	AboutCompany          service.AboutCompanyServiceInterface
+	FaqSection            service.FaqSectionServiceInterface
+	OurTeam               service.OurTeamServiceInterface
+	AboutCompanyKeynote   service.AboutCompanyKeynoteServiceInterface
+	ServiceSection        service.ServiceSectionServiceInterface
+	Appointment           service.AppointmentServiceInterface
+	PortofolioSection     service.PortofolioSectionServiceInterface
+	PortofolioDetail      service.PortofolioDetailServiceInterface
+	PortofolioTestimonial service.PortofolioTestimonialServiceInterface
+	ContactUs             service.ContactUsServiceInterface
+	ServiceDetail         service.ServiceDetailServiceInterface
+	ContentRevision       service.ContentRevisionServiceInterface
+	AuditLog              service.AuditLogServiceInterface
+	Trash                 service.TrashServiceInterface
+	ContentPosition       service.ContentPositionServiceInterface
+	ContentTranslation    service.ContentTranslationServiceInterface
+	Site                  service.SiteServiceInterface
+	Health                health.HealthInterface
+}
+
+// RegisterRoutes registers the routes of every handler on e. The server and
+// the OpenAPI drift test both call it, so they always see the same routes.
+func RegisterRoutes(e *echo.Echo, cfg *config.Config, services Services) {
+	e.GET("/api/check", func(c echo.Context) error {
+		return c.String(200, "OK")
+	})
+
+	NewUserHandler(e, services.User)
+	NewUploadImage(e, services.MediaAsset, cfg)
+	NewUploadDocument(e, services.MediaAsset, cfg)
+	NewChunkedUploadHandler(e, services.ChunkedUpload, cfg)
+	NewPresignedUploadHandler(e, services.MediaAsset, cfg)
+	NewHeroSectionHandler(e, cfg, services.HeroSection)
+	NewClientSectionHandler(e, services.ClientSection, cfg)
+	NewAboutCompanyHandler(e, services.AboutCompany, cfg)
+	NewFaqSectionHandler(e, services.FaqSection, cfg)
+	NewOurTeamHandler(e, cfg, services.OurTeam)
+	NewAboutCompanyKeynoteHandler(e, services.AboutCompanyKeynote, cfg)
+	NewServiceSectionHandler(e, services.ServiceSection, cfg)
+	NewAppointmentHandler(e, services.Appointment, cfg)
+	NewPortofolioSectionHandler(e, services.PortofolioSection, cfg)
+	NewPortofolioDetailHandler(e, services.PortofolioDetail, cfg)
+	NewPortofolioTestimonialHandler(e, services.PortofolioTestimonial, cfg)
+	NewContactUsHandler(e, services.ContactUs, cfg)
+	NewServiceDetailHandler(e, services.ServiceDetail, cfg)
+	NewContentRevisionHandler(e, services.ContentRevision, cfg)
+	NewAuditLogHandler(e, services.AuditLog, cfg)
+	NewTrashHandler(e, services.Trash, cfg)
+	NewContentPositionHandler(e, services.ContentPosition, cfg)
+	NewContentTranslationHandler(e, services.ContentTranslation, cfg)
+	NewMediaAssetHandler(e, services.MediaAsset, cfg)
+	NewSiteHandler(e, services.Site, cfg)
+	NewOpenApiHandler(e)
+	NewMetricsHandler(e, cfg)
+	NewHealthHandler(e, services.Health, cfg)
+}
@@ -1,17 +1,22 @@
 package handler
 
 import (
+	"fmt"
 	"latihan-compro/config"
 	"latihan-compro/internal/adapter/handler/request"
 	"latihan-compro/internal/adapter/handler/response"
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/auth"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
+	"mime"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type ServiceDetailHandlerInterface interface {
@@ -22,41 +27,37 @@ type ServiceDetailHandlerInterface interface {
 	DeleteByIDServiceDetail(c echo.Context) error
 
 	FetchServiceDetailByServiceID(c echo.Context) error
+	DownloadServiceDetail(c echo.Context) error
+	FetchDownloadServiceDetail(c echo.Context) error
 }
 
 type serviceDetailHandler struct {
 	serviceDetailService service.ServiceDetailServiceInterface
+	urlSigner            auth.UrlSignerInterface
 }
 
 // CreateServiceDetail implements ServiceDetailHandlerInterface.
 func (cs *serviceDetailHandler) CreateServiceDetail(c echo.Context) error {
 	var (
-		req       = request.ServiceDetailRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.ServiceDetailRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreateServiceDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateServiceDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateServiceDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateServiceDetail - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateServiceDetail - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateServiceDetail - 3")
+		return err
 	}
 
 	reqEntity := entity.ServiceDetailEntity{
@@ -68,12 +69,10 @@ func (cs *serviceDetailHandler) CreateServiceDetail(c echo.Context) error {
 		PathDocx:    req.PathDocx,
 	}
 
-	err = cs.serviceDetailService.CreateServiceDetail(ctx, reqEntity)
+	err := cs.serviceDetailService.CreateServiceDetail(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateServiceDetail - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateServiceDetail - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create service section"
@@ -87,37 +86,33 @@ func (cs *serviceDetailHandler) CreateServiceDetail(c echo.Context) error {
 func (cs *serviceDetailHandler) FetchAllServiceDetail(c echo.Context) error {
 	var (
 		resp              = response.DefaultSuccessResponse{}
-		respError         = response.ErrorResponseDefault{}
 		ctx               = c.Request().Context()
 		respServiceDetail = []response.ServiceDetailResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllServiceDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllServiceDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.serviceDetailService.FetchAllServiceDetail(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllServiceDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllServiceDetail - 2")
+		return err
 	}
 
 	for _, val := range results {
 		respServiceDetail = append(respServiceDetail, response.ServiceDetailResponse{
-			ID:          val.ID,
-			ServiceID:   val.ServiceID,
-			PathImage:   val.PathImage,
-			Title:       val.Title,
-			Description: val.Description,
-			PathPdf:     val.PathPdf,
-			PathDocx:    val.PathDocx,
-			ServiceName: val.ServiceName,
+			ID:            val.ID,
+			ServiceID:     val.ServiceID,
+			PathImage:     val.PathImage,
+			ImageVariants: upload.ImageVariants(val.PathImage),
+			Title:         val.Title,
+			Description:   val.Description,
+			PathPdf:       val.PathPdf,
+			PathDocx:      val.PathDocx,
+			ServiceName:   val.ServiceName,
 		})
 	}
 
@@ -132,39 +127,33 @@ func (cs *serviceDetailHandler) FetchAllServiceDetail(c echo.Context) error {
 func (cs *serviceDetailHandler) FetchByIDServiceDetail(c echo.Context) error {
 	var (
 		resp              = response.DefaultSuccessResponse{}
-		respError         = response.ErrorResponseDefault{}
 		ctx               = c.Request().Context()
 		respServiceDetail = response.ServiceDetailResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDServiceDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDServiceDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idServiceDetail := c.Param("id")
 	id, err := conv.StringToInt64(idServiceDetail)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDServiceDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDServiceDetail - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.serviceDetailService.FetchByIDServiceDetail(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDServiceDetail - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDServiceDetail - 3")
+		return err
 	}
 
 	respServiceDetail.ID = result.ID
 	respServiceDetail.ServiceID = result.ServiceID
 	respServiceDetail.PathImage = result.PathImage
+	respServiceDetail.ImageVariants = upload.ImageVariants(result.PathImage)
 	respServiceDetail.Title = result.Title
 	respServiceDetail.Description = result.Description
 	respServiceDetail.PathPdf = result.PathPdf
@@ -180,41 +169,32 @@ func (cs *serviceDetailHandler) FetchByIDServiceDetail(c echo.Context) error {
 // EditByIDServiceDetail implements ServiceDetailHandlerInterface.
 func (cs *serviceDetailHandler) EditByIDServiceDetail(c echo.Context) error {
 	var (
-		req       = request.ServiceDetailRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.ServiceDetailRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDServiceDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDServiceDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idServiceDetail := c.Param("id")
 	id, err := conv.StringToInt64(idServiceDetail)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDServiceDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDServiceDetail - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDServiceDetail - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDServiceDetail - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDServiceDetail - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDServiceDetail - 4")
+		return err
 	}
 
 	reqEntity := entity.ServiceDetailEntity{
@@ -229,10 +209,8 @@ func (cs *serviceDetailHandler) EditByIDServiceDetail(c echo.Context) error {
 
 	err = cs.serviceDetailService.EditByIDServiceDetail(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDServiceDetail - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDServiceDetail - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit service section"
 	resp.Meta.Status = true
@@ -244,34 +222,27 @@ func (cs *serviceDetailHandler) EditByIDServiceDetail(c echo.Context) error {
 // DeleteByIDServiceDetail implements ServiceDetailHandlerInterface.
 func (cs *serviceDetailHandler) DeleteByIDServiceDetail(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDServiceDetail - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDServiceDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idServiceDetail := c.Param("id")
 	id, err := conv.StringToInt64(idServiceDetail)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDServiceDetail - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDServiceDetail - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.serviceDetailService.DeleteByIDServiceDetail(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDServiceDetail - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDServiceDetail - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete service section"
 	resp.Meta.Status = true
@@ -281,47 +252,34 @@ func (cs *serviceDetailHandler) DeleteByIDServiceDetail(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
-// FetchServiceDetail implements ServiceDetailHandlerInterface.
+// FetchServiceDetailByServiceID implements ServiceDetailHandlerInterface.
 func (cs *serviceDetailHandler) FetchServiceDetailByServiceID(c echo.Context) error {
 	var (
 		resp              = response.DefaultSuccessResponse{}
-		respError         = response.ErrorResponseDefault{}
 		ctx               = c.Request().Context()
 		respServiceDetail = response.ServiceDetailResponse{}
 	)
 
-	user := conv.GetUserIDByContext(c)
-	if user == 0 {
-		log.Errorf("[HANDLER] FetchServiceDetailByServiceID - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
-	}
-
-	idServiceID := c.Param("id")
-	id, err := conv.StringToInt64(idServiceID)
+	id, err := conv.StringToInt64(c.QueryParam("service_id"))
 	if err != nil {
-		log.Errorf("[HANDLER] FetchServiceDetailByServiceID - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchServiceDetailByServiceID - 1")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.serviceDetailService.GetByServiceIDDetail(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchServiceDetailByServiceID - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchServiceDetailByServiceID - 2")
+		return err
 	}
 
 	respServiceDetail.ID = result.ID
 	respServiceDetail.ServiceID = result.ServiceID
 	respServiceDetail.PathImage = result.PathImage
+	respServiceDetail.ImageVariants = upload.ImageVariants(result.PathImage)
 	respServiceDetail.Title = result.Title
 	respServiceDetail.Description = result.Description
-	respServiceDetail.PathPdf = result.PathPdf
-	respServiceDetail.PathDocx = result.PathDocx
+	respServiceDetail.PathPdf = cs.downloadUrl(result.ID, entity.ServiceDetailFilePdf, result.PathPdf)
+	respServiceDetail.PathDocx = cs.downloadUrl(result.ID, entity.ServiceDetailFileDocx, result.PathDocx)
 	respServiceDetail.ServiceName = result.ServiceName
 	resp.Meta.Message = "Success fetch service section by ID"
 	resp.Meta.Status = true
@@ -329,15 +287,116 @@ func (cs *serviceDetailHandler) FetchServiceDetailByServiceID(c echo.Context) er
 	resp.Pagination = nil
 	return c.JSON(http.StatusOK, resp)
 }
+
+// DownloadServiceDetail implements ServiceDetailHandlerInterface.
+func (cs *serviceDetailHandler) DownloadServiceDetail(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+	)
+
+	idServiceDetail := c.Param("id")
+	id, err := conv.StringToInt64(idServiceDetail)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DownloadServiceDetail - 1")
+		return conv.ErrBadParamInput
+	}
+
+	fileType := c.Param("type")
+	err = cs.urlSigner.VerifyUrl(serviceDetailDownloadPath(id, fileType), c.QueryParam("expires"), c.QueryParam("signature"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DownloadServiceDetail - 2")
+		return err
+	}
+
+	result, err := cs.serviceDetailService.DownloadServiceDetail(ctx, id, fileType)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DownloadServiceDetail - 3")
+		return err
+	}
+
+	// Files uploaded before the media library have no known storage path.
+	if result.Reader == nil {
+		return c.Redirect(http.StatusFound, result.Url)
+	}
+
+	defer result.Reader.Close()
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": result.FileName}))
+	c.Response().Header().Set("Cache-Control", "private, no-store")
+	if result.Size > 0 {
+		c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(result.Size, 10))
+	}
+	return c.Stream(http.StatusOK, result.ContentType, result.Reader)
+}
+
+// FetchDownloadServiceDetail implements ServiceDetailHandlerInterface.
+func (cs *serviceDetailHandler) FetchDownloadServiceDetail(c echo.Context) error {
+	var (
+		resp         = response.DefaultSuccessResponse{}
+		ctx          = c.Request().Context()
+		respDownload = []response.ServiceDetailDownloadResponse{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchDownloadServiceDetail - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	idServiceDetail := c.Param("id")
+	id, err := conv.StringToInt64(idServiceDetail)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchDownloadServiceDetail - 2")
+		return conv.ErrBadParamInput
+	}
+
+	results, err := cs.serviceDetailService.FetchDownloadServiceDetail(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchDownloadServiceDetail - 3")
+		return err
+	}
+
+	for _, val := range results {
+		respDownload = append(respDownload, response.ServiceDetailDownloadResponse{
+			FileType:         val.FileType,
+			Total:            val.Total,
+			LastDownloadedAt: val.LastDownloadedAt,
+		})
+	}
+
+	resp.Meta.Message = "Success fetch service detail downloads"
+	resp.Meta.Status = true
+	resp.Data = respDownload
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// downloadUrl replaces a stored document url with an expiring signed url to
+// the download endpoint, so public clients never see the storage location.
+func (cs *serviceDetailHandler) downloadUrl(id int64, fileType string, path *string) *string {
+	if path == nil || *path == "" {
+		return nil
+	}
+
+	signedUrl := cs.urlSigner.SignUrl(serviceDetailDownloadPath(id, fileType))
+	return &signedUrl
+}
+
+func serviceDetailDownloadPath(id int64, fileType string) string {
+	return fmt.Sprintf("/service-details/%d/download/%s", id, fileType)
+}
+
 func NewServiceDetailHandler(e *echo.Echo, serviceDetailService service.ServiceDetailServiceInterface, cfg *config.Config) ServiceDetailHandlerInterface {
 	h := &serviceDetailHandler{
 		serviceDetailService: serviceDetailService,
+		urlSigner:            auth.NewUrlSigner(cfg),
 	}
 
 	mid := middleware.NewMiddleware(cfg)
 
 	serviceDetailApp := e.Group("/service-details")
-	serviceDetailApp.GET("", h.FetchServiceDetailByServiceID)
+	serviceDetailApp.GET("", h.FetchServiceDetailByServiceID, mid.Locale())
+	serviceDetailApp.GET("/:id/download/:type", h.DownloadServiceDetail)
 
 	adminApp := serviceDetailApp.Group("/admin", mid.CheckToken())
 
@@ -346,6 +405,7 @@ func NewServiceDetailHandler(e *echo.Echo, serviceDetailService service.ServiceD
 	adminApp.GET("/:id", h.FetchByIDServiceDetail)
 	adminApp.PUT("/:id", h.EditByIDServiceDetail)
 	adminApp.DELETE("/:id", h.DeleteByIDServiceDetail)
+	adminApp.GET("/:id/downloads", h.FetchDownloadServiceDetail)
 
 	return h
 }
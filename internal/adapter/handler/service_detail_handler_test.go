@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"latihan-compro/config"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+type fakeServiceDetailService struct {
+	service.ServiceDetailServiceInterface
+}
+
+func (f *fakeServiceDetailService) GetByServiceIDDetail(ctx context.Context, serviceId int64) (*entity.ServiceDetailEntity, error) {
+	pdf := "private/documents/brochure.pdf"
+	return &entity.ServiceDetailEntity{ID: 7, ServiceID: serviceId, Title: "Web development", PathPdf: &pdf}, nil
+}
+
+func (f *fakeServiceDetailService) DownloadServiceDetail(ctx context.Context, id int64, fileType string) (*entity.ServiceDetailFileEntity, error) {
+	return &entity.ServiceDetailFileEntity{
+		FileName:    "brochure.pdf",
+		ContentType: "application/pdf",
+		Reader:      io.NopCloser(strings.NewReader("%PDF-1.7")),
+	}, nil
+}
+
+func TestServiceDetailPublicRoutes(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.App.JwtSecretKey = "service-detail-test"
+
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	NewServiceDetailHandler(e, &fakeServiceDetailService{}, cfg)
+
+	get := func(target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		return rec
+	}
+
+	if rec := get("/service-details"); rec.Code != http.StatusBadRequest {
+		t.Errorf("without service_id = %d, want 400", rec.Code)
+	}
+
+	rec := get("/service-details?service_id=3")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("anonymous request = %d: %s", rec.Code, rec.Body)
+	}
+	var body struct {
+		Data struct {
+			ServiceID int64   `json:"service_id"`
+			PathPdf   *string `json:"path_pdf"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Data.ServiceID != 3 || body.Data.PathPdf == nil || !strings.HasPrefix(*body.Data.PathPdf, "/service-details/7/download/pdf?") {
+		t.Fatalf("response = %s, want a signed download url", rec.Body)
+	}
+
+	if rec = get(*body.Data.PathPdf); rec.Code != http.StatusOK || rec.Body.String() != "%PDF-1.7" {
+		t.Errorf("signed download = %d: %s", rec.Code, rec.Body)
+	}
+	if rec = get("/service-details/7/download/pdf"); rec.Code == http.StatusOK {
+		t.Error("unsigned download succeeded")
+	}
+}
@@ -8,10 +8,11 @@ import (
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/conv"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type ServiceSectionHandlerInterface interface {
@@ -32,32 +33,25 @@ type serviceSectionHandler struct {
 
 func (cs *serviceSectionHandler) CreateServiceSection(c echo.Context) error {
 	var (
-		req       = request.ServiceSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.ServiceSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] CreateServiceSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] CreateServiceSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
-	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] CreateServiceSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+	if err := c.Bind(&req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateServiceSection - 2")
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] CreateServiceSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+	if err := c.Validate(req); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateServiceSection - 3")
+		return err
 	}
 
 	reqEntity := entity.ServiceSectionEntity{
@@ -66,12 +60,10 @@ func (cs *serviceSectionHandler) CreateServiceSection(c echo.Context) error {
 		Tagline:  req.Tagline,
 	}
 
-	err = cs.serviceSectionService.CreateServiceSection(ctx, reqEntity)
+	err := cs.serviceSectionService.CreateServiceSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] CreateServiceSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] CreateServiceSection - 4")
+		return err
 	}
 
 	resp.Meta.Message = "Success create service section"
@@ -85,33 +77,30 @@ func (cs *serviceSectionHandler) CreateServiceSection(c echo.Context) error {
 func (cs *serviceSectionHandler) FetchAllServiceSection(c echo.Context) error {
 	var (
 		resp               = response.DefaultSuccessResponse{}
-		respError          = response.ErrorResponseDefault{}
 		ctx                = c.Request().Context()
 		respServiceSection = []response.ServiceSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchAllServiceSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllServiceSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	results, err := cs.serviceSectionService.FetchAllServiceSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllServiceSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllServiceSection - 2")
+		return err
 	}
 
 	for _, val := range results {
 		respServiceSection = append(respServiceSection, response.ServiceSectionResponse{
-			ID:       val.ID,
-			Name:     val.Name,
-			Tagline:  val.Tagline,
-			PathIcon: val.PathIcon,
+			ID:           val.ID,
+			Position:     val.Position,
+			Name:         val.Name,
+			Tagline:      val.Tagline,
+			PathIcon:     val.PathIcon,
+			IconVariants: upload.ImageVariants(val.PathIcon),
 		})
 	}
 
@@ -126,40 +115,35 @@ func (cs *serviceSectionHandler) FetchAllServiceSection(c echo.Context) error {
 func (cs *serviceSectionHandler) FetchByIDServiceSection(c echo.Context) error {
 	var (
 		resp               = response.DefaultSuccessResponse{}
-		respError          = response.ErrorResponseDefault{}
 		ctx                = c.Request().Context()
 		respServiceSection = response.ServiceSectionResponse{}
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] FetchByIDServiceSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchByIDServiceSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idServiceSection := c.Param("id")
 	id, err := conv.StringToInt64(idServiceSection)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDServiceSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDServiceSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	result, err := cs.serviceSectionService.FetchByIDServiceSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchByIDServiceSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchByIDServiceSection - 3")
+		return err
 	}
 
 	respServiceSection.ID = result.ID
+	respServiceSection.Position = result.Position
 	respServiceSection.Name = result.Name
 	respServiceSection.Tagline = result.Tagline
 	respServiceSection.PathIcon = result.PathIcon
+	respServiceSection.IconVariants = upload.ImageVariants(result.PathIcon)
 	resp.Meta.Message = "Success fetch service section by ID"
 	resp.Meta.Status = true
 	resp.Data = respServiceSection
@@ -170,41 +154,32 @@ func (cs *serviceSectionHandler) FetchByIDServiceSection(c echo.Context) error {
 // EditByIDServiceSection implements ServiceSectionHandlerInterface.
 func (cs *serviceSectionHandler) EditByIDServiceSection(c echo.Context) error {
 	var (
-		req       = request.ServiceSectionRequest{}
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		req  = request.ServiceSectionRequest{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] EditByIDServiceSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] EditByIDServiceSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idServiceSection := c.Param("id")
 	id, err := conv.StringToInt64(idServiceSection)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDServiceSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDServiceSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	if err = c.Bind(&req); err != nil {
-		log.Errorf("[HANDLER] EditByIDServiceSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDServiceSection - 3")
+		return bindError(err)
 	}
 
 	if err = c.Validate(req); err != nil {
-		log.Errorf("[HANDLER] EditByIDServiceSection - 4: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDServiceSection - 4")
+		return err
 	}
 
 	reqEntity := entity.ServiceSectionEntity{
@@ -216,10 +191,8 @@ func (cs *serviceSectionHandler) EditByIDServiceSection(c echo.Context) error {
 
 	err = cs.serviceSectionService.EditByIDServiceSection(ctx, reqEntity)
 	if err != nil {
-		log.Errorf("[HANDLER] EditByIDServiceSection - 5: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] EditByIDServiceSection - 5")
+		return err
 	}
 	resp.Meta.Message = "Success edit service section"
 	resp.Meta.Status = true
@@ -231,34 +204,27 @@ func (cs *serviceSectionHandler) EditByIDServiceSection(c echo.Context) error {
 // DeleteByIDServiceSection implements ServiceSectionHandlerInterface.
 func (cs *serviceSectionHandler) DeleteByIDServiceSection(c echo.Context) error {
 	var (
-		resp      = response.DefaultSuccessResponse{}
-		respError = response.ErrorResponseDefault{}
-		ctx       = c.Request().Context()
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 
 	user := conv.GetUserIDByContext(c)
 	if user == 0 {
-		log.Errorf("[HANDLER] DeleteByIDServiceSection - 1: Unauthorized")
-		respError.Meta.Message = "Unauthorized"
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnauthorized, respError)
+		log.Ctx(ctx).Error().Msg("[HANDLER] DeleteByIDServiceSection - 1: Unauthorized")
+		return conv.ErrUnauthorized
 	}
 
 	idServiceSection := c.Param("id")
 	id, err := conv.StringToInt64(idServiceSection)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDServiceSection - 2: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDServiceSection - 2")
+		return conv.ErrBadParamInput
 	}
 
 	err = cs.serviceSectionService.DeleteByIDServiceSection(ctx, id)
 	if err != nil {
-		log.Errorf("[HANDLER] DeleteByIDServiceSection - 3: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] DeleteByIDServiceSection - 3")
+		return err
 	}
 	resp.Meta.Message = "Success delete service section"
 	resp.Meta.Status = true
@@ -273,24 +239,23 @@ func (cs *serviceSectionHandler) FetchAllServiceHome(c echo.Context) error {
 	var (
 		respServices = []response.ServiceSectionResponse{}
 		resp         = response.DefaultSuccessResponse{}
-		respError    = response.ErrorResponseDefault{}
 		ctx          = c.Request().Context()
 	)
 
 	results, err := cs.serviceSectionService.FetchAllServiceSection(ctx)
 	if err != nil {
-		log.Errorf("[HANDLER] FetchAllServiceHome - 1: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllServiceHome - 1")
+		return err
 	}
 
 	for _, val := range results {
 		respServices = append(respServices, response.ServiceSectionResponse{
-			ID:       val.ID,
-			Name:     val.Name,
-			Tagline:  val.Tagline,
-			PathIcon: val.PathIcon,
+			ID:           val.ID,
+			Position:     val.Position,
+			Name:         val.Name,
+			Tagline:      val.Tagline,
+			PathIcon:     val.PathIcon,
+			IconVariants: upload.ImageVariants(val.PathIcon),
 		})
 	}
 	resp.Data = respServices
@@ -308,7 +273,7 @@ func NewServiceSectionHandler(e *echo.Echo, serviceSectionService service.Servic
 	mid := middleware.NewMiddleware(cfg)
 
 	serviceSectionApp := e.Group("/service-sections")
-	serviceSectionApp.GET("", h.FetchAllServiceHome)
+	serviceSectionApp.GET("", h.FetchAllServiceHome, mid.Locale())
 
 	adminApp := serviceSectionApp.Group("/admin", mid.CheckToken())
 
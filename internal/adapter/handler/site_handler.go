@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type SiteHandlerInterface interface {
+	FetchSite(c echo.Context) error
+}
+
+type siteHandler struct {
+	siteService service.SiteServiceInterface
+}
+
+// FetchSite implements SiteHandlerInterface.
+// ?include=hero_section,faq_sections limits the sections, all by default.
+func (h *siteHandler) FetchSite(c echo.Context) error {
+	var (
+		resp     = response.DefaultSuccessResponse{}
+		ctx      = c.Request().Context()
+		sections = entity.SiteSections
+	)
+
+	if include := c.QueryParam("include"); include != "" {
+		sections = nil
+		for _, section := range strings.Split(include, ",") {
+			section = strings.TrimSpace(section)
+			if !slices.Contains(entity.SiteSections, section) {
+				log.Ctx(ctx).Error().Msgf("[HANDLER] FetchSite - 1: unknown section %s", section)
+				return errs.Validation("unknown_section", "unknown section "+section)
+			}
+			if !slices.Contains(sections, section) {
+				sections = append(sections, section)
+			}
+		}
+	}
+
+	result, err := h.siteService.FetchSite(ctx, sections)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchSite - 2")
+		return err
+	}
+
+	resp.Meta.Message = "Success fetch site"
+	if len(result.Errors) > 0 {
+		resp.Meta.Message = "Success fetch site, some sections failed to load"
+		// Keep a partial page out of every cache, ours and the CDN's.
+		c.Response().Header().Set("Cache-Control", "no-store")
+	}
+	resp.Meta.Status = true
+	resp.Data = toSiteResponse(result)
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+func toSiteResponse(site *entity.SiteEntity) response.SiteResponse {
+	respSite := response.SiteResponse{}
+
+	if val := site.HeroSection; val != nil {
+		respSite.HeroSection = &response.HeroSectionResponse{
+			ID:             val.ID,
+			Heading:        val.Heading,
+			SubHeading:     val.SubHeading,
+			PathVideo:      val.PathVideo,
+			Banner:         val.Banner,
+			BannerVariants: upload.ImageVariants(val.Banner),
+		}
+	}
+
+	for _, val := range site.ClientSections {
+		respSite.ClientSections = append(respSite.ClientSections, response.ClientSectionResponse{
+			ID:           val.ID,
+			Position:     val.Position,
+			Name:         val.Name,
+			PathIcon:     val.PathIcon,
+			IconVariants: upload.ImageVariants(val.PathIcon),
+		})
+	}
+
+	if val := site.AboutCompany; val != nil {
+		respSite.AboutCompany = &response.AboutCompanyResponse{
+			ID:          val.ID,
+			Description: val.Description,
+		}
+		for _, keynote := range val.Keynote {
+			respSite.AboutCompany.CompanyKeynotes = append(respSite.AboutCompany.CompanyKeynotes, response.AboutCompanyKeynoteResponse{
+				ID:             keynote.ID,
+				AboutCompanyID: keynote.AboutCompanyID,
+				Keynote:        keynote.Keynote,
+				PathImage:      keynote.PathImage,
+				ImageVariants:  upload.ImageVariants(keynote.PathImage),
+			})
+		}
+	}
+
+	for _, val := range site.ServiceSections {
+		respSite.ServiceSections = append(respSite.ServiceSections, response.ServiceSectionResponse{
+			ID:           val.ID,
+			Position:     val.Position,
+			Name:         val.Name,
+			Tagline:      val.Tagline,
+			PathIcon:     val.PathIcon,
+			IconVariants: upload.ImageVariants(val.PathIcon),
+		})
+	}
+
+	for _, val := range site.PortofolioSections {
+		respSite.PortofolioSections = append(respSite.PortofolioSections, response.PortofolioSectionResponse{
+			ID:                val.ID,
+			Position:          val.Position,
+			Name:              val.Name,
+			Tagline:           val.Tagline,
+			Thumbnail:         val.Thumbnail,
+			ThumbnailVariants: upload.ImageVariants(val.Thumbnail),
+		})
+	}
+
+	for _, val := range site.PortofolioTestimonials {
+		respSite.PortofolioTestimonials = append(respSite.PortofolioTestimonials, response.PortofolioTestimonialResponse{
+			ID:                val.ID,
+			Thumbnail:         val.Thumbnail,
+			ThumbnailVariants: upload.ImageVariants(val.Thumbnail),
+			Message:           val.Message,
+			ClientName:        val.ClientName,
+			Role:              val.Role,
+			PortofolioSection: response.PortofolioSectionResponse{
+				Name: val.PortofolioSection.Name,
+			},
+		})
+	}
+
+	for _, val := range site.OurTeams {
+		respSite.OurTeams = append(respSite.OurTeams, response.OurTeamResponse{
+			ID:            val.ID,
+			Position:      val.Position,
+			Name:          val.Name,
+			Role:          val.Role,
+			PathPhoto:     val.PathPhoto,
+			PhotoVariants: upload.ImageVariants(val.PathPhoto),
+			Tagline:       val.Tagline,
+		})
+	}
+
+	for _, val := range site.FaqSections {
+		respSite.FaqSections = append(respSite.FaqSections, response.FaqSectionResponse{
+			ID:          val.ID,
+			Position:    val.Position,
+			Title:       val.Title,
+			Description: val.Description,
+		})
+	}
+
+	if val := site.ContactUs; val != nil {
+		respSite.ContactUs = &response.ContactUsResponse{
+			ID:           val.ID,
+			CompanyName:  val.CompanyName,
+			LocationName: val.LocationName,
+			Address:      val.Address,
+			PhoneNumber:  val.PhoneNumber,
+		}
+	}
+
+	// Only the error codes are sent, internal ones masked like in
+	// HTTPErrorHandler, the causes are logged by the site service.
+	if len(site.Errors) > 0 {
+		respSite.Errors = map[string]string{}
+		for section, err := range site.Errors {
+			respSite.Errors[section] = errs.CodeOf(err)
+		}
+	}
+
+	return respSite
+}
+
+func NewSiteHandler(e *echo.Echo, siteService service.SiteServiceInterface, cfg *config.Config) SiteHandlerInterface {
+	h := &siteHandler{
+		siteService: siteService,
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	e.GET("/site", h.FetchSite, mid.Locale())
+
+	return h
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"encoding/json"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/middleware"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type TrashHandlerInterface interface {
+	FetchAllTrash(c echo.Context) error
+	RestoreTrash(c echo.Context) error
+	PurgeTrash(c echo.Context) error
+}
+
+type trashHandler struct {
+	trashService service.TrashServiceInterface
+}
+
+// FetchAllTrash implements TrashHandlerInterface.
+func (cs *trashHandler) FetchAllTrash(c echo.Context) error {
+	var (
+		resp      = response.DefaultSuccessResponse{}
+		ctx       = c.Request().Context()
+		respTrash = []response.TrashResponse{}
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] FetchAllTrash - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	results, err := cs.trashService.FetchAllTrash(ctx, contentTypeFromPath(c))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] FetchAllTrash - 2")
+		return err
+	}
+
+	for _, val := range results {
+		respTrash = append(respTrash, response.TrashResponse{
+			ContentType: val.ContentType,
+			ID:          val.ID,
+			Data:        json.RawMessage(val.Snapshot),
+			DeletedAt:   val.DeletedAt,
+		})
+	}
+
+	resp.Meta.Message = "Success fetch all trash"
+	resp.Meta.Status = true
+	resp.Data = respTrash
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// RestoreTrash implements TrashHandlerInterface.
+func (cs *trashHandler) RestoreTrash(c echo.Context) error {
+	var (
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] RestoreTrash - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] RestoreTrash - 2")
+		return conv.ErrBadParamInput
+	}
+
+	err = cs.trashService.RestoreTrash(ctx, contentTypeFromPath(c), id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] RestoreTrash - 3")
+		return err
+	}
+
+	resp.Meta.Message = "Success restore trash"
+	resp.Meta.Status = true
+	resp.Data = nil
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// PurgeTrash implements TrashHandlerInterface.
+func (cs *trashHandler) PurgeTrash(c echo.Context) error {
+	var (
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+
+	user := conv.GetUserIDByContext(c)
+	if user == 0 {
+		log.Ctx(ctx).Error().Msg("[HANDLER] PurgeTrash - 1: Unauthorized")
+		return conv.ErrUnauthorized
+	}
+
+	id, err := conv.StringToInt64(c.Param("id"))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] PurgeTrash - 2")
+		return conv.ErrBadParamInput
+	}
+
+	err = cs.trashService.PurgeTrash(ctx, contentTypeFromPath(c), id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[HANDLER] PurgeTrash - 3")
+		return err
+	}
+
+	resp.Meta.Message = "Success purge trash"
+	resp.Meta.Status = true
+	resp.Data = nil
+	resp.Pagination = nil
+	return c.JSON(http.StatusOK, resp)
+}
+
+// contentTypeFromPath returns the route group of the matched route, which is
+// the content type for routes registered per content type.
+func contentTypeFromPath(c echo.Context) string {
+	return strings.Split(strings.TrimPrefix(c.Path(), "/"), "/")[0]
+}
+
+func NewTrashHandler(e *echo.Echo, trashService service.TrashServiceInterface, cfg *config.Config) TrashHandlerInterface {
+	h := &trashHandler{
+		trashService: trashService,
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	for _, contentType := range entity.ContentTypes {
+		trashApp := e.Group("/"+contentType+"/admin/trash", mid.CheckToken())
+
+		trashApp.GET("", h.FetchAllTrash)
+		trashApp.POST("/:id/restore", h.RestoreTrash)
+		trashApp.DELETE("/:id", h.PurgeTrash)
+	}
+
+	return h
+}
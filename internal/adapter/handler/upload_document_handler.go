@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/handler/response"
+	"latihan-compro/internal/core/domain/errs"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type UploadDocumentInterface interface {
+	UploadDocument(c echo.Context) error
+}
+
+type uploadDocument struct {
+	mediaAssetService service.MediaAssetServiceInterface
+	uploadPolicy      *upload.Policy
+}
+
+// UploadDocument implements UploadDocumentInterface.
+func (u *uploadDocument) UploadDocument(c echo.Context) error {
+	var (
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
+	)
+	file, err := c.FormFile("file")
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error getting file")
+		return errs.Wrap(err, errs.KindValidation, "missing_file", "form field file is required")
+	}
+
+	src, err := u.uploadPolicy.Open(file)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error opening file")
+		return uploadError(err)
+	}
+
+	defer src.Close()
+
+	result, err := u.mediaAssetService.UploadDocumentMediaAsset(ctx, src)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error uploading file")
+		return uploadError(err)
+	}
+
+	resp.Meta.Status = true
+	resp.Meta.Message = "Success upload document"
+	resp.Data = response.UploadDocumentResponse{
+		ID:          result.ID,
+		Url:         result.Url,
+		ContentType: result.ContentType,
+		Size:        result.Size,
+	}
+	resp.Pagination = nil
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func NewUploadDocument(e *echo.Echo, mediaAssetService service.MediaAssetServiceInterface, cfg *config.Config) UploadDocumentInterface {
+	res := &uploadDocument{
+		mediaAssetService: mediaAssetService,
+		uploadPolicy:      upload.NewDocumentPolicy(cfg),
+	}
+
+	mid := middleware.NewMiddleware(cfg)
+
+	e.POST("/upload-document", res.UploadDocument, mid.CheckToken())
+
+	return res
+}
@@ -1,19 +1,17 @@
 package handler
 
 import (
-	"bytes"
-	"fmt"
-	"io"
+	"errors"
 	"latihan-compro/config"
 	"latihan-compro/internal/adapter/handler/response"
-	"latihan-compro/internal/adapter/storage"
+	"latihan-compro/internal/core/domain/errs"
+	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/middleware"
+	"latihan-compro/utils/upload"
 	"net/http"
-	"time"
 
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type UploadImageInterface interface {
@@ -21,73 +19,69 @@ type UploadImageInterface interface {
 }
 
 type uploadImage struct {
-	storageService storage.SupabaseInterface
+	mediaAssetService service.MediaAssetServiceInterface
+	uploadPolicy      *upload.Policy
 }
 
 // UploadImage implements UploadImageInterface.
 func (u *uploadImage) UploadImage(c echo.Context) error {
 	var (
-		respError = response.ErrorResponseDefault{}
-		resp      = response.DefaultSuccessResponse{}
+		resp = response.DefaultSuccessResponse{}
+		ctx  = c.Request().Context()
 	)
 	file, err := c.FormFile("file")
 	if err != nil {
-		log.Errorf("Error getting file: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(400, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("Error getting file")
+		return errs.Wrap(err, errs.KindValidation, "missing_file", "form field file is required")
 	}
 
-	src, err := file.Open()
+	src, err := u.uploadPolicy.Open(file)
 	if err != nil {
-		log.Errorf("Error opening file: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(400, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("Error opening file")
+		return uploadError(err)
 	}
 
 	defer src.Close()
 
-	fileBuffer := new(bytes.Buffer)
-	_, err = io.Copy(fileBuffer, src)
+	result, err := u.mediaAssetService.UploadMediaAsset(ctx, src, c.FormValue("alt_text"))
 	if err != nil {
-		log.Errorf("Error copying file: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(400, respError)
-	}
-
-	newFileName := fmt.Sprintf("%s_%d%s", uuid.New().String(), time.Now().Unix(), getExtension(file.Filename))
-
-	uploadPath := fmt.Sprintf("public/uploads/%s", newFileName)
-	url, err := u.storageService.UploadFile(uploadPath, fileBuffer)
-	if err != nil {
-		log.Errorf("Error uploading file: %v", err)
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(400, respError)
+		log.Ctx(ctx).Error().Err(err).Msg("Error uploading file")
+		return uploadError(err)
 	}
 
 	resp.Meta.Status = true
 	resp.Meta.Message = "Success upload image"
-	resp.Data = map[string]string{
-		"url": url,
+	resp.Data = response.UploadImageResponse{
+		ID:          result.ID,
+		Url:         result.Url,
+		ContentType: result.ContentType,
+		Variants:    upload.ImageVariants(result.Url),
 	}
 	resp.Pagination = nil
 	return c.JSON(http.StatusCreated, resp)
 }
 
-func getExtension(fileName string) string {
-	ext := "." + fileName[len(fileName)-3:] // Ambil 3 karakter terakhir untuk ekstensi
-	if len(fileName) > 4 && fileName[len(fileName)-4] == '.' {
-		ext = "." + fileName[len(fileName)-4:]
+// uploadError returns a rejected upload as an echo error carrying its HTTP
+// status, any other error is returned as is for HTTPErrorHandler.
+func uploadError(err error) error {
+	status := 0
+	switch {
+	case errors.Is(err, upload.ErrTypeNotAllowed):
+		status = http.StatusUnsupportedMediaType
+	case errors.Is(err, upload.ErrFileTooLarge), errors.Is(err, upload.ErrImageTooLarge):
+		status = http.StatusRequestEntityTooLarge
+	case errors.Is(err, upload.ErrInvalidImage):
+		status = http.StatusUnprocessableEntity
+	default:
+		return err
 	}
-	return ext
+	return echo.NewHTTPError(status, err.Error()).SetInternal(err)
 }
 
-func NewUploadImage(e *echo.Echo, storageService storage.SupabaseInterface, cfg *config.Config) UploadImageInterface {
+func NewUploadImage(e *echo.Echo, mediaAssetService service.MediaAssetServiceInterface, cfg *config.Config) UploadImageInterface {
 	res := &uploadImage{
-		storageService: storageService,
+		mediaAssetService: mediaAssetService,
+		uploadPolicy:      upload.NewPolicy(cfg),
 	}
 
 	mid := middleware.NewMiddleware(cfg)
@@ -5,7 +5,6 @@ import (
 	"latihan-compro/internal/adapter/handler/response"
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/service"
-	"latihan-compro/utils/conv"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -20,32 +19,23 @@ type userHandler struct {
 	userService service.UserServiceInterface
 }
 
-var (
-	err error
-)
-
 // LoginAdmin implements UserHandler.
 func (u *userHandler) LoginAdmin(c echo.Context) error {
 	var (
 		req       = request.LoginRequest{}
 		resp      = response.DefaultSuccessResponse{}
 		respLogin = response.LoginResponse{}
-		respError = response.ErrorResponseDefault{}
 		ctx       = c.Request().Context()
 	)
 
-	if err = c.Bind(&req); err != nil {
+	if err := c.Bind(&req); err != nil {
 		// code := "[HANDLER] LoginAdmin - 1"
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusUnprocessableEntity, respError)
+		return bindError(err)
 	}
 
-	if err = c.Validate(req); err != nil {
+	if err := c.Validate(req); err != nil {
 		// code = "[HANDLER] LoginAdmin - 2"
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(http.StatusBadRequest, respError)
+		return err
 	}
 
 	reqEntity := entity.UserEntity{
@@ -55,9 +45,7 @@ func (u *userHandler) LoginAdmin(c echo.Context) error {
 	token, err := u.userService.LoginAdmin(ctx, reqEntity)
 	if err != nil {
 		// code = "[HANDLER] LoginAdmin - 3"
-		respError.Meta.Message = err.Error()
-		respError.Meta.Status = false
-		return c.JSON(conv.SetHTTPStatusCode(err), respError)
+		return err
 	}
 
 	respLogin.Token = token
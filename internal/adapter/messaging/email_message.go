@@ -1,15 +1,25 @@
 package messaging
 
 import (
+	"context"
 	"crypto/tls"
 	"latihan-compro/config"
+	"latihan-compro/utils/metrics"
+	"latihan-compro/utils/tracing"
+	"net"
+	"net/smtp"
+	"strconv"
 
 	"github.com/go-mail/mail"
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
 type EmailMessagingInterface interface {
-	SendEmailAppointment(attach *string, from, subject, body string) error
+	SendEmailAppointment(ctx context.Context, attach *string, from, subject, body string) error
+	// Ping dials the SMTP server and waits for its greeting, for readiness
+	// checks. No mail is sent.
+	Ping(ctx context.Context) error
 }
 
 type emailAttributes struct {
@@ -22,7 +32,13 @@ type emailAttributes struct {
 }
 
 // SendEmailAppointment implements EmailMessagingInterface.
-func (e *emailAttributes) SendEmailAppointment(attach *string, from, subject string, body string) error {
+func (e *emailAttributes) SendEmailAppointment(ctx context.Context, attach *string, from, subject string, body string) error {
+	ctx, span := tracing.Start(ctx, "email.SendEmailAppointment",
+		semconv.ServerAddress(e.host),
+		semconv.ServerPort(e.port),
+	)
+	defer span.End()
+
 	m := mail.NewMessage()
 	m.SetHeader("From", from)
 	m.SetHeader("To", e.receiver)
@@ -39,13 +55,41 @@ func (e *emailAttributes) SendEmailAppointment(attach *string, from, subject str
 		InsecureSkipVerify: true,
 	}
 
-	if err := d.DialAndSend(m); err != nil {
-		log.Errorf("error sending mail: %v", err)
+	err := d.DialAndSend(m)
+	metrics.ObserveEmail(err)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("error sending mail")
+		tracing.Fail(span, err)
 		return err
 	}
 	return nil
 }
 
+// Ping implements EmailMessagingInterface.
+func (e *emailAttributes) Ping(ctx context.Context) error {
+	address := net.JoinHostPort(e.host, strconv.Itoa(e.port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	// Port 465 speaks TLS from the start, like the mail dialer assumes.
+	if e.port == 465 {
+		conn = tls.Client(conn, &tls.Config{ServerName: e.host, InsecureSkipVerify: true})
+	}
+
+	client, err := smtp.NewClient(conn, e.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	return client.Quit()
+}
+
 func NewEmailMessaging(cfg *config.Config) EmailMessagingInterface {
 	return &emailAttributes{
 		username: cfg.Email.Username,
@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 
 	"gorm.io/gorm"
 )
@@ -25,14 +25,14 @@ type aboutCompanyKeynoteRepository struct {
 
 // FetchByCompanyID implements AboutCompanyKeynoteInterface.
 func (h *aboutCompanyKeynoteRepository) FetchByCompanyID(ctx context.Context, companyId int64) ([]entity.AboutCompanyKeynoteEntity, error) {
-	rows, err := h.DB.Table("about_company_keynotes as ack").
+	rows, err := dbConn(ctx, h.DB).Table("about_company_keynotes as ack").
 		Select("ack.id", "ack.keypoint", "ack.about_company_id", "ack.path_image", "ac.description").
 		Joins("inner join about_company as ac on ac.id = ack.about_company_id").
 		Where("ack.about_company_id = ? AND ack.deleted_at IS NULL", companyId).
 		Rows()
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByCompanyID - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByCompanyID - 1")
+		return nil, dbError(err, "about company keynote")
 	}
 
 	var aboutCompanyKeynoteRepositoryEntities []entity.AboutCompanyKeynoteEntity
@@ -40,8 +40,8 @@ func (h *aboutCompanyKeynoteRepository) FetchByCompanyID(ctx context.Context, co
 		aboutCompanyKeynote := entity.AboutCompanyKeynoteEntity{}
 		err = rows.Scan(&aboutCompanyKeynote.ID, &aboutCompanyKeynote.Keynote, &aboutCompanyKeynote.AboutCompanyID, &aboutCompanyKeynote.PathImage, &aboutCompanyKeynote.AboutCompanyDescription)
 		if err != nil {
-			log.Errorf("[REPOSITORY] FetchByCompanyID - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByCompanyID - 2")
+			return nil, dbError(err, "about company keynote")
 		}
 		aboutCompanyKeynoteRepositoryEntities = append(aboutCompanyKeynoteRepositoryEntities, aboutCompanyKeynote)
 	}
@@ -57,9 +57,9 @@ func (h *aboutCompanyKeynoteRepository) CreateAboutCompanyKeynote(ctx context.Co
 		PathImage:      &req.PathImage,
 	}
 
-	if err = h.DB.Create(&modelAboutCompanyKeynote).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateAboutCompanyKeynote - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Create(&modelAboutCompanyKeynote).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateAboutCompanyKeynote - 1")
+		return dbError(err, "about company keynote")
 	}
 	return nil
 }
@@ -68,14 +68,14 @@ func (h *aboutCompanyKeynoteRepository) CreateAboutCompanyKeynote(ctx context.Co
 func (h *aboutCompanyKeynoteRepository) DeleteByIDAboutCompanyKeynote(ctx context.Context, id int64) error {
 	modelAboutCompanyKeynote := model.AboutCompanyKeynote{}
 
-	if err = h.DB.Where("id = ?", id).First(&modelAboutCompanyKeynote).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDAboutCompanyKeynote - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelAboutCompanyKeynote).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDAboutCompanyKeynote - 1")
+		return dbError(err, "about company keynote")
 	}
 
-	if err = h.DB.Delete(&modelAboutCompanyKeynote).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDAboutCompanyKeynote - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Delete(&modelAboutCompanyKeynote).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDAboutCompanyKeynote - 2")
+		return dbError(err, "about company keynote")
 	}
 	return nil
 }
@@ -84,31 +84,31 @@ func (h *aboutCompanyKeynoteRepository) DeleteByIDAboutCompanyKeynote(ctx contex
 func (h *aboutCompanyKeynoteRepository) EditByIDAboutCompanyKeynote(ctx context.Context, req entity.AboutCompanyKeynoteEntity) error {
 	modelAboutCompanyKeynote := model.AboutCompanyKeynote{}
 
-	if err = h.DB.Where("id =?", req.ID).First(&modelAboutCompanyKeynote).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDAboutCompanyKeynote - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelAboutCompanyKeynote).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDAboutCompanyKeynote - 1")
+		return dbError(err, "about company keynote")
 	}
 	modelAboutCompanyKeynote.AboutCompanyID = req.AboutCompanyID
 	modelAboutCompanyKeynote.Keypoint = req.Keynote
 	modelAboutCompanyKeynote.PathImage = &req.PathImage
 
-	if err = h.DB.Save(&modelAboutCompanyKeynote).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDAboutCompanyKeynote - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Save(&modelAboutCompanyKeynote).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDAboutCompanyKeynote - 2")
+		return dbError(err, "about company keynote")
 	}
 	return nil
 }
 
 // FetchAllAboutCompanyKeynote implements AboutCompanyKeynoteInterface.
 func (h *aboutCompanyKeynoteRepository) FetchAllAboutCompanyKeynote(ctx context.Context) ([]entity.AboutCompanyKeynoteEntity, error) {
-	rows, err := h.DB.Table("about_company_keynotes as ack").
+	rows, err := dbConn(ctx, h.DB).Table("about_company_keynotes as ack").
 		Select("ack.id", "ack.keypoint", "ack.about_company_id", "ack.path_image", "ac.description").
 		Joins("inner join about_company as ac on ac.id = ack.about_company_id").
 		Where("ack.deleted_at IS NULL").
 		Rows()
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllAboutCompanyKeynote - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllAboutCompanyKeynote - 1")
+		return nil, dbError(err, "about company keynote")
 	}
 
 	var aboutCompanyKeynoteRepositoryEntities []entity.AboutCompanyKeynoteEntity
@@ -116,8 +116,8 @@ func (h *aboutCompanyKeynoteRepository) FetchAllAboutCompanyKeynote(ctx context.
 		aboutCompanyKeynote := entity.AboutCompanyKeynoteEntity{}
 		err = rows.Scan(&aboutCompanyKeynote.ID, &aboutCompanyKeynote.Keynote, &aboutCompanyKeynote.AboutCompanyID, &aboutCompanyKeynote.PathImage, &aboutCompanyKeynote.AboutCompanyDescription)
 		if err != nil {
-			log.Errorf("[REPOSITORY] FetchAllAboutCompanyKeynote - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllAboutCompanyKeynote - 2")
+			return nil, dbError(err, "about company keynote")
 		}
 		aboutCompanyKeynoteRepositoryEntities = append(aboutCompanyKeynoteRepositoryEntities, aboutCompanyKeynote)
 	}
@@ -127,22 +127,22 @@ func (h *aboutCompanyKeynoteRepository) FetchAllAboutCompanyKeynote(ctx context.
 
 // FetchByIDAboutCompanyKeynote implements AboutCompanyKeynoteInterface.
 func (h *aboutCompanyKeynoteRepository) FetchByIDAboutCompanyKeynote(ctx context.Context, id int64) (*entity.AboutCompanyKeynoteEntity, error) {
-	rows, err := h.DB.Table("about_company_keynotes as ack").
+	rows, err := dbConn(ctx, h.DB).Table("about_company_keynotes as ack").
 		Select("ack.id", "ack.keypoint", "ack.about_company_id", "ack.path_image", "ac.description").
 		Joins("inner join about_company as ac on ac.id = ack.about_company_id").
 		Where("ack.id = ? AND ack.deleted_at IS NULL", id).
 		Rows()
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDAboutCompanyKeynote - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDAboutCompanyKeynote - 1")
+		return nil, dbError(err, "about company keynote")
 	}
 
 	respEntity := entity.AboutCompanyKeynoteEntity{}
 	for rows.Next() {
 		err = rows.Scan(&respEntity.ID, &respEntity.Keynote, &respEntity.AboutCompanyID, &respEntity.PathImage, &respEntity.AboutCompanyDescription)
 		if err != nil {
-			log.Errorf("[REPOSITORY] FetchByIDAboutCompanyKeynote - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDAboutCompanyKeynote - 2")
+			return nil, dbError(err, "about company keynote")
 		}
 	}
 	return &respEntity, nil
@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -25,18 +25,35 @@ type aboutCompanyRepository struct {
 // FetchAllCompanyAndKeynote implements AboutCompanyInterface.
 func (h *aboutCompanyRepository) FetchAllCompanyAndKeynote(ctx context.Context) (*entity.AboutCompanyEntity, error) {
 	modelAboutCompany := model.AboutCompany{}
-	err := h.DB.Select("id", "description").Order("created_at DESC").Limit(1).Find(&modelAboutCompany).Error
+	err := dbConn(ctx, h.DB).Select("id", "description").Order("created_at DESC").Limit(1).Find(&modelAboutCompany).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllCompanyAndKeynote - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllCompanyAndKeynote - 1")
+		return nil, dbError(err, "about company")
 	}
 
 	var aboutCompanyRepositoryEntities entity.AboutCompanyEntity
 	var aboutCompanyKeynoteModel []model.AboutCompanyKeynote
-	err = h.DB.Select("id", "keypoint", "path_image", "about_company_id").Where("about_company_id = ?", modelAboutCompany.ID).Find(&aboutCompanyKeynoteModel).Error
+	err = dbConn(ctx, h.DB).Select("id", "keypoint", "path_image", "about_company_id").Where("about_company_id = ?", modelAboutCompany.ID).Find(&aboutCompanyKeynoteModel).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllCompanyAndKeynote - 2: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllCompanyAndKeynote - 2")
+		return nil, dbError(err, "about company")
+	}
+
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypeAboutCompany, modelAboutCompany.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllCompanyAndKeynote - 3")
+		return nil, dbError(err, "about company")
+	}
+
+	var keynoteIDs []int64
+	for _, val := range aboutCompanyKeynoteModel {
+		keynoteIDs = append(keynoteIDs, val.ID)
+	}
+
+	keynoteTranslations, err := contentTranslations(ctx, h.DB, entity.ContentTypeAboutCompanyKeynote, keynoteIDs...)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllCompanyAndKeynote - 4")
+		return nil, dbError(err, "about company")
 	}
 
 	var aboutCompanyKeynoteEntity []entity.AboutCompanyKeynoteEntity
@@ -44,13 +61,13 @@ func (h *aboutCompanyRepository) FetchAllCompanyAndKeynote(ctx context.Context)
 		aboutCompanyKeynoteEntity = append(aboutCompanyKeynoteEntity, entity.AboutCompanyKeynoteEntity{
 			ID:             val.ID,
 			AboutCompanyID: modelAboutCompany.ID,
-			Keynote:        val.Keypoint,
+			Keynote:        keynoteTranslations.value(val.ID, "keypoint", val.Keypoint),
 			PathImage:      *val.PathImage,
 		})
 	}
 
 	aboutCompanyRepositoryEntities.ID = modelAboutCompany.ID
-	aboutCompanyRepositoryEntities.Description = modelAboutCompany.Description
+	aboutCompanyRepositoryEntities.Description = translations.value(modelAboutCompany.ID, "description", modelAboutCompany.Description)
 	aboutCompanyRepositoryEntities.Keynote = aboutCompanyKeynoteEntity
 
 	return &aboutCompanyRepositoryEntities, nil
@@ -62,9 +79,9 @@ func (h *aboutCompanyRepository) CreateAboutCompany(ctx context.Context, req ent
 		Description: req.Description,
 	}
 
-	if err := h.DB.Create(&modelAboutCompany).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateAboutCompany - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Create(&modelAboutCompany).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateAboutCompany - 1")
+		return dbError(err, "about company")
 	}
 	return nil
 }
@@ -72,16 +89,16 @@ func (h *aboutCompanyRepository) CreateAboutCompany(ctx context.Context, req ent
 // DeleteByIDAboutCompany implements AboutCompanyInterface.
 func (h *aboutCompanyRepository) DeleteByIDAboutCompany(ctx context.Context, id int64) error {
 	modelAboutCompany := model.AboutCompany{}
-	err := h.DB.Where("id = ?", id).First(&modelAboutCompany).Error
+	err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelAboutCompany).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDAboutCompany - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDAboutCompany - 1")
+		return dbError(err, "about company")
 	}
 
-	err = h.DB.Delete(&modelAboutCompany).Error
+	err = dbConn(ctx, h.DB).Delete(&modelAboutCompany).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDAboutCompany - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDAboutCompany - 2")
+		return dbError(err, "about company")
 	}
 	return nil
 }
@@ -89,17 +106,17 @@ func (h *aboutCompanyRepository) DeleteByIDAboutCompany(ctx context.Context, id
 // EditByIDAboutCompany implements AboutCompanyInterface.
 func (h *aboutCompanyRepository) EditByIDAboutCompany(ctx context.Context, req entity.AboutCompanyEntity) error {
 	modelAboutCompany := model.AboutCompany{}
-	err := h.DB.Where("id =?", req.ID).First(&modelAboutCompany).Error
+	err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelAboutCompany).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDAboutCompany - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDAboutCompany - 1")
+		return dbError(err, "about company")
 	}
 	modelAboutCompany.Description = req.Description
 
-	err = h.DB.Save(&modelAboutCompany).Error
+	err = dbConn(ctx, h.DB).Save(&modelAboutCompany).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDAboutCompany - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDAboutCompany - 2")
+		return dbError(err, "about company")
 	}
 	return nil
 }
@@ -107,10 +124,10 @@ func (h *aboutCompanyRepository) EditByIDAboutCompany(ctx context.Context, req e
 // FetchAllAboutCompany implements AboutCompanyInterface.
 func (h *aboutCompanyRepository) FetchAllAboutCompany(ctx context.Context) ([]entity.AboutCompanyEntity, error) {
 	modelAboutCompany := []model.AboutCompany{}
-	err := h.DB.Select("id", "description").Order("created_at DESC").Find(&modelAboutCompany).Error
+	err := dbConn(ctx, h.DB).Select("id", "description").Order("created_at DESC").Find(&modelAboutCompany).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllAboutCompany - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllAboutCompany - 1")
+		return nil, dbError(err, "about company")
 	}
 
 	var aboutCompanyRepositoryEntities []entity.AboutCompanyEntity
@@ -127,10 +144,10 @@ func (h *aboutCompanyRepository) FetchAllAboutCompany(ctx context.Context) ([]en
 // FetchByIDAboutCompany implements AboutCompanyInterface.
 func (h *aboutCompanyRepository) FetchByIDAboutCompany(ctx context.Context, id int64) (*entity.AboutCompanyEntity, error) {
 	modelAboutCompany := model.AboutCompany{}
-	err := h.DB.Select("id", "description").Where("id = ?", id).First(&modelAboutCompany).Error
+	err := dbConn(ctx, h.DB).Select("id", "description").Where("id = ?", id).First(&modelAboutCompany).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDAboutCompany - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDAboutCompany - 1")
+		return nil, dbError(err, "about company")
 	}
 
 	return &entity.AboutCompanyEntity{
@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -21,7 +21,20 @@ type appointmentRepository struct {
 
 // FetchByIDAppointment implements AppointmentRepositoryInterface.
 func (h *appointmentRepository) FetchByIDAppointment(ctx context.Context, id int64) (*entity.AppointmentEntity, error) {
-	panic("unimplemented")
+	appointment := entity.AppointmentEntity{}
+
+	err := dbConn(ctx, h.DB).
+		Table("appointments as a").
+		Select("a.id", "a.service_id", "a.name", "a.phone_number", "a.email", "a.brief", "a.budget", "a.meet_at", "ss.name as service_name").
+		Joins("inner join service_sections as ss on ss.id = a.service_id").
+		Where("a.id = ? AND a.deleted_at IS NULL", id).
+		Take(&appointment).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDAppointment - 1")
+		return nil, dbError(err, "appointment")
+	}
+
+	return &appointment, nil
 }
 
 // CreateAppointment implements AppointmentRepositoryInterface.
@@ -36,9 +49,9 @@ func (h *appointmentRepository) CreateAppointment(ctx context.Context, req entit
 		MeetAt:      req.MeetAt,
 	}
 
-	if err = h.DB.Create(&modelAppointment).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateAppointment - 1: %v", err)
-		return "", err
+	if err := dbConn(ctx, h.DB).Create(&modelAppointment).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateAppointment - 1")
+		return "", dbError(err, "appointment")
 	}
 
 	return modelAppointment.Email, nil
@@ -47,15 +60,15 @@ func (h *appointmentRepository) CreateAppointment(ctx context.Context, req entit
 
 // FetchAllAppointment implements AppointmentInterface.
 func (h *appointmentRepository) FetchAllAppointment(ctx context.Context) ([]entity.AppointmentEntity, error) {
-	rows, err := h.DB.
+	rows, err := dbConn(ctx, h.DB).
 		Table("appointments as a").
 		Select("a.id", "a.name", "a.email", "a.budget", "ss.name").
 		Joins("inner join service_sections as ss on ss.id = a.service_id").
 		Where("a.deleted_at IS NULL").
 		Rows()
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllAppointment - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllAppointment - 1")
+		return nil, dbError(err, "appointment")
 	}
 
 	var appointmentRepositoryEntities []entity.AppointmentEntity
@@ -63,8 +76,8 @@ func (h *appointmentRepository) FetchAllAppointment(ctx context.Context) ([]enti
 		var appointment entity.AppointmentEntity
 		err = rows.Scan(&appointment.ID, &appointment.Name, &appointment.Email, &appointment.Budget, &appointment.ServiceName)
 		if err != nil {
-			log.Errorf("[REPOSITORY] FetchAllAppointment - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllAppointment - 2")
+			return nil, dbError(err, "appointment")
 		}
 		appointmentRepositoryEntities = append(appointmentRepositoryEntities, appointment)
 	}
@@ -76,14 +89,14 @@ func (h *appointmentRepository) FetchAllAppointment(ctx context.Context) ([]enti
 func (h *appointmentRepository) DeleteByIDAppointment(ctx context.Context, id int64) error {
 	modelAppointment := model.Appointment{}
 
-	if err = h.DB.Where("id = ?", id).First(&modelAppointment).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDAppointment - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelAppointment).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDAppointment - 1")
+		return dbError(err, "appointment")
 	}
 
-	if err = h.DB.Delete(&modelAppointment).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDAppointment - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Delete(&modelAppointment).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDAppointment - 2")
+		return dbError(err, "appointment")
 	}
 	return nil
 }
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/model"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type AuditLogRepositoryInterface interface {
+	CreateAuditLog(ctx context.Context, req entity.AuditLogEntity) error
+	FetchAllAuditLog(ctx context.Context, filter entity.AuditLogFilterEntity) ([]entity.AuditLogEntity, int64, error)
+	DeleteAuditLogBefore(ctx context.Context, before time.Time) (int64, error)
+	FetchContentSnapshot(ctx context.Context, contentType string, contentID int64) (*string, error)
+}
+
+type auditLogRepository struct {
+	DB *gorm.DB
+}
+
+// CreateAuditLog implements AuditLogRepositoryInterface.
+func (h *auditLogRepository) CreateAuditLog(ctx context.Context, req entity.AuditLogEntity) error {
+	modelAuditLog := model.AuditLog{
+		Action:      req.Action,
+		EntityType:  req.EntityType,
+		BeforeValue: req.BeforeValue,
+		AfterValue:  req.AfterValue,
+		IpAddress:   req.IpAddress,
+		UserAgent:   req.UserAgent,
+	}
+	if req.ActorID != 0 {
+		modelAuditLog.ActorID = &req.ActorID
+	}
+	if req.EntityID != 0 {
+		modelAuditLog.EntityID = &req.EntityID
+	}
+
+	if err := dbConn(ctx, h.DB).Create(&modelAuditLog).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateAuditLog - 1")
+		return dbError(err, "audit log")
+	}
+	return nil
+}
+
+// FetchAllAuditLog implements AuditLogRepositoryInterface.
+func (h *auditLogRepository) FetchAllAuditLog(ctx context.Context, filter entity.AuditLogFilterEntity) ([]entity.AuditLogEntity, int64, error) {
+	query := dbConn(ctx, h.DB).Model(&model.AuditLog{})
+	if filter.ActorID != 0 {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != 0 {
+		query = query.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllAuditLog - 1")
+		return nil, 0, dbError(err, "audit log")
+	}
+
+	modelAuditLogs := []model.AuditLog{}
+	err := query.Order("created_at DESC").
+		Offset((filter.Page - 1) * filter.PerPage).
+		Limit(filter.PerPage).
+		Find(&modelAuditLogs).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllAuditLog - 2")
+		return nil, 0, dbError(err, "audit log")
+	}
+
+	var auditLogEntities []entity.AuditLogEntity
+	for _, v := range modelAuditLogs {
+		auditLog := entity.AuditLogEntity{
+			ID:          v.ID,
+			Action:      v.Action,
+			EntityType:  v.EntityType,
+			BeforeValue: v.BeforeValue,
+			AfterValue:  v.AfterValue,
+			IpAddress:   v.IpAddress,
+			UserAgent:   v.UserAgent,
+			CreatedAt:   v.CreatedAt,
+		}
+		if v.ActorID != nil {
+			auditLog.ActorID = *v.ActorID
+		}
+		if v.EntityID != nil {
+			auditLog.EntityID = *v.EntityID
+		}
+		auditLogEntities = append(auditLogEntities, auditLog)
+	}
+
+	return auditLogEntities, total, nil
+}
+
+// DeleteAuditLogBefore implements AuditLogRepositoryInterface.
+func (h *auditLogRepository) DeleteAuditLogBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := dbConn(ctx, h.DB).Where("created_at < ?", before).Delete(&model.AuditLog{})
+	if result.Error != nil {
+		log.Ctx(ctx).Error().Err(result.Error).Msg("[REPOSITORY] DeleteAuditLogBefore - 1")
+		return 0, dbError(result.Error, "audit log")
+	}
+	return result.RowsAffected, nil
+}
+
+// FetchContentSnapshot implements AuditLogRepositoryInterface.
+// Entity types without a content model (uploads, appointments, ...) have no
+// snapshot, which is not an error for the audit trail.
+func (h *auditLogRepository) FetchContentSnapshot(ctx context.Context, contentType string, contentID int64) (*string, error) {
+	if _, ok := contentModels[contentType]; !ok {
+		return nil, nil
+	}
+
+	snapshot, err := snapshotContent(dbConn(ctx, h.DB), contentType, contentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchContentSnapshot - 1")
+		return nil, dbError(err, "audit log")
+	}
+	return &snapshot, nil
+}
+
+func NewAuditLogRepository(DB *gorm.DB) AuditLogRepositoryInterface {
+	return &auditLogRepository{
+		DB: DB,
+	}
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/model"
+	"latihan-compro/utils/conv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type ChunkedUploadRepositoryInterface interface {
+	CreateChunkedUpload(ctx context.Context, req entity.ChunkedUploadEntity) error
+	FetchByIDChunkedUpload(ctx context.Context, id string) (*entity.ChunkedUploadEntity, error)
+	AppendChunkChunkedUpload(ctx context.Context, id string, chunk entity.ChunkEntity) error
+	CompleteChunkedUpload(ctx context.Context, id string, mediaAssetID int64) error
+	DeleteByIDChunkedUpload(ctx context.Context, id string) error
+	FetchExpiredChunkedUpload(ctx context.Context, before time.Time) ([]entity.ChunkedUploadEntity, error)
+}
+
+type chunkedUploadRepository struct {
+	DB *gorm.DB
+}
+
+// CreateChunkedUpload implements ChunkedUploadRepositoryInterface.
+func (h *chunkedUploadRepository) CreateChunkedUpload(ctx context.Context, req entity.ChunkedUploadEntity) error {
+	modelChunkedUpload := model.ChunkedUpload{
+		ID:         req.ID,
+		Length:     req.Length,
+		ChunkPaths: "[]",
+	}
+	if req.UploaderID != 0 {
+		modelChunkedUpload.UploaderID = &req.UploaderID
+	}
+	if req.FileName != "" {
+		modelChunkedUpload.FileName = &req.FileName
+	}
+
+	if err := dbConn(ctx, h.DB).Create(&modelChunkedUpload).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateChunkedUpload - 1")
+		return dbError(err, "chunked upload")
+	}
+	return nil
+}
+
+// FetchByIDChunkedUpload implements ChunkedUploadRepositoryInterface.
+func (h *chunkedUploadRepository) FetchByIDChunkedUpload(ctx context.Context, id string) (*entity.ChunkedUploadEntity, error) {
+	modelChunkedUpload := model.ChunkedUpload{}
+	err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelChunkedUpload).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDChunkedUpload - 1")
+		return nil, dbError(err, "chunked upload")
+	}
+
+	chunkedUpload := toChunkedUploadEntity(modelChunkedUpload)
+	if modelChunkedUpload.MediaAssetID != nil {
+		err = dbConn(ctx, h.DB).Model(&model.MediaAsset{}).
+			Where("id = ?", *modelChunkedUpload.MediaAssetID).
+			Pluck("url", &chunkedUpload.Url).Error
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDChunkedUpload - 2")
+			return nil, dbError(err, "chunked upload")
+		}
+	}
+
+	return &chunkedUpload, nil
+}
+
+// AppendChunkChunkedUpload implements ChunkedUploadRepositoryInterface.
+// The update only applies while the upload is still at the chunk offset, so
+// of two concurrent requests for the same offset only one is accepted.
+func (h *chunkedUploadRepository) AppendChunkChunkedUpload(ctx context.Context, id string, chunk entity.ChunkEntity) error {
+	chunkPath, err := json.Marshal([]string{chunk.Path})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] AppendChunkChunkedUpload - 1")
+		return dbError(err, "chunked upload")
+	}
+
+	updates := map[string]interface{}{
+		"upload_offset": gorm.Expr("upload_offset + ?", chunk.Size),
+		"chunk_paths":   gorm.Expr("chunk_paths || ?::jsonb", string(chunkPath)),
+		"updated_at":    time.Now(),
+	}
+	if chunk.ContentType != "" {
+		updates["content_type"] = chunk.ContentType
+		updates["extension"] = chunk.Extension
+	}
+
+	result := dbConn(ctx, h.DB).Model(&model.ChunkedUpload{}).
+		Where("id = ? AND upload_offset = ? AND completed_at IS NULL", id, chunk.Offset).
+		Updates(updates)
+	if result.Error != nil {
+		log.Ctx(ctx).Error().Err(result.Error).Msg("[REPOSITORY] AppendChunkChunkedUpload - 2")
+		return dbError(result.Error, "chunked upload")
+	}
+
+	if result.RowsAffected == 0 {
+		log.Ctx(ctx).Error().Err(conv.ErrUploadOffsetMismatch).Msg("[REPOSITORY] AppendChunkChunkedUpload - 3")
+		return conv.ErrUploadOffsetMismatch
+	}
+	return nil
+}
+
+// CompleteChunkedUpload implements ChunkedUploadRepositoryInterface.
+func (h *chunkedUploadRepository) CompleteChunkedUpload(ctx context.Context, id string, mediaAssetID int64) error {
+	now := time.Now()
+	err := dbConn(ctx, h.DB).Model(&model.ChunkedUpload{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"media_asset_id": mediaAssetID,
+			"chunk_paths":    "[]",
+			"completed_at":   now,
+			"updated_at":     now,
+		}).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CompleteChunkedUpload - 1")
+		return dbError(err, "chunked upload")
+	}
+	return nil
+}
+
+// DeleteByIDChunkedUpload implements ChunkedUploadRepositoryInterface.
+func (h *chunkedUploadRepository) DeleteByIDChunkedUpload(ctx context.Context, id string) error {
+	result := dbConn(ctx, h.DB).Where("id = ?", id).Delete(&model.ChunkedUpload{})
+	if result.Error != nil {
+		log.Ctx(ctx).Error().Err(result.Error).Msg("[REPOSITORY] DeleteByIDChunkedUpload - 1")
+		return dbError(result.Error, "chunked upload")
+	}
+
+	if result.RowsAffected == 0 {
+		log.Ctx(ctx).Error().Err(conv.ErrNotFound).Msg("[REPOSITORY] DeleteByIDChunkedUpload - 2")
+		return conv.ErrNotFound
+	}
+	return nil
+}
+
+// FetchExpiredChunkedUpload implements ChunkedUploadRepositoryInterface.
+// It returns the uploads started before the given time and never completed.
+func (h *chunkedUploadRepository) FetchExpiredChunkedUpload(ctx context.Context, before time.Time) ([]entity.ChunkedUploadEntity, error) {
+	modelChunkedUploads := []model.ChunkedUpload{}
+	err := dbConn(ctx, h.DB).Where("completed_at IS NULL AND created_at < ?", before).
+		Find(&modelChunkedUploads).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchExpiredChunkedUpload - 1")
+		return nil, dbError(err, "chunked upload")
+	}
+
+	var chunkedUploadEntities []entity.ChunkedUploadEntity
+	for _, v := range modelChunkedUploads {
+		chunkedUploadEntities = append(chunkedUploadEntities, toChunkedUploadEntity(v))
+	}
+
+	return chunkedUploadEntities, nil
+}
+
+func toChunkedUploadEntity(v model.ChunkedUpload) entity.ChunkedUploadEntity {
+	chunkedUpload := entity.ChunkedUploadEntity{
+		ID:          v.ID,
+		Length:      v.Length,
+		Offset:      v.UploadOffset,
+		CompletedAt: v.CompletedAt,
+		CreatedAt:   v.CreatedAt,
+	}
+	if v.UploaderID != nil {
+		chunkedUpload.UploaderID = *v.UploaderID
+	}
+	if v.FileName != nil {
+		chunkedUpload.FileName = *v.FileName
+	}
+	if v.ContentType != nil {
+		chunkedUpload.ContentType = *v.ContentType
+	}
+	if v.Extension != nil {
+		chunkedUpload.Extension = *v.Extension
+	}
+	if v.MediaAssetID != nil {
+		chunkedUpload.MediaAssetID = *v.MediaAssetID
+	}
+	if err := json.Unmarshal([]byte(v.ChunkPaths), &chunkedUpload.ChunkPaths); err != nil {
+		log.Error().Err(err).Msg("[REPOSITORY] toChunkedUploadEntity - 1")
+	}
+	return chunkedUpload
+}
+
+func NewChunkedUploadRepository(DB *gorm.DB) ChunkedUploadRepositoryInterface {
+	return &chunkedUploadRepository{
+		DB: DB,
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -27,9 +27,9 @@ func (h *clientSectionRepository) CreateClientSection(ctx context.Context, req e
 		PathIcon: req.PathIcon,
 	}
 
-	if err = h.DB.Create(&modelClientSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateClientSection - 1: %v", err)
-		return err
+	if err := createLast(dbConn(ctx, h.DB), &modelClientSection, &modelClientSection.Position); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateClientSection - 1")
+		return dbError(err, "client section")
 	}
 	return nil
 }
@@ -37,16 +37,17 @@ func (h *clientSectionRepository) CreateClientSection(ctx context.Context, req e
 // FetchAllClientSection implements ClientSectionInterface.
 func (h *clientSectionRepository) FetchAllClientSection(ctx context.Context) ([]entity.ClientSectionEntity, error) {
 	modelClientSection := []model.ClientSection{}
-	err = h.DB.Select("id", "name", "path_icon").Find(&modelClientSection).Order("created_at DESC").Error
+	err := dbConn(ctx, h.DB).Select("id", "name", "path_icon", "position").Order("position ASC, id ASC").Find(&modelClientSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllClientSection - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllClientSection - 1")
+		return nil, dbError(err, "client section")
 	}
 
 	var clientSectionRepositoryEntities []entity.ClientSectionEntity
 	for _, v := range modelClientSection {
 		clientSectionRepositoryEntities = append(clientSectionRepositoryEntities, entity.ClientSectionEntity{
 			ID:       v.ID,
+			Position: v.Position,
 			Name:     v.Name,
 			PathIcon: v.PathIcon,
 		})
@@ -58,14 +59,15 @@ func (h *clientSectionRepository) FetchAllClientSection(ctx context.Context) ([]
 // FetchByIDClientSection implements ClientSectionInterface.
 func (h *clientSectionRepository) FetchByIDClientSection(ctx context.Context, id int64) (*entity.ClientSectionEntity, error) {
 	modelClientSection := model.ClientSection{}
-	err = h.DB.Select("id", "name", "path_icon").Where("id = ?", id).First(&modelClientSection).Error
+	err := dbConn(ctx, h.DB).Select("id", "name", "path_icon", "position").Where("id = ?", id).First(&modelClientSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDClientSection - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDClientSection - 1")
+		return nil, dbError(err, "client section")
 	}
 
 	return &entity.ClientSectionEntity{
 		ID:       modelClientSection.ID,
+		Position: modelClientSection.Position,
 		Name:     modelClientSection.Name,
 		PathIcon: modelClientSection.PathIcon,
 	}, nil
@@ -75,17 +77,17 @@ func (h *clientSectionRepository) FetchByIDClientSection(ctx context.Context, id
 func (h *clientSectionRepository) EditByIDClientSection(ctx context.Context, req entity.ClientSectionEntity) error {
 	modelClientSection := model.ClientSection{}
 
-	err = h.DB.Where("id =?", req.ID).First(&modelClientSection).Error
+	err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelClientSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDClientSection - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDClientSection - 1")
+		return dbError(err, "client section")
 	}
 	modelClientSection.Name = req.Name
 	modelClientSection.PathIcon = req.PathIcon
-	err = h.DB.Save(&modelClientSection).Error
+	err = dbConn(ctx, h.DB).Save(&modelClientSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDClientSection - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDClientSection - 2")
+		return dbError(err, "client section")
 	}
 	return nil
 }
@@ -94,16 +96,16 @@ func (h *clientSectionRepository) EditByIDClientSection(ctx context.Context, req
 func (h *clientSectionRepository) DeleteByIDClientSection(ctx context.Context, id int64) error {
 	modelClientSection := model.ClientSection{}
 
-	err = h.DB.Where("id = ?", id).First(&modelClientSection).Error
+	err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelClientSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDClientSection - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDClientSection - 1")
+		return dbError(err, "client section")
 	}
 
-	err = h.DB.Delete(&modelClientSection).Error
+	err = dbConn(ctx, h.DB).Delete(&modelClientSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDClientSection - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDClientSection - 2")
+		return dbError(err, "client section")
 	}
 	return nil
 }
@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -29,9 +29,9 @@ func (h *contactUsRepository) CreateContactUs(ctx context.Context, req entity.Co
 		PhoneNumber:  req.PhoneNumber,
 	}
 
-	if err = h.DB.Create(&modelContactUs).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateContactUs - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Create(&modelContactUs).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateContactUs - 1")
+		return dbError(err, "contact us")
 	}
 	return nil
 }
@@ -39,10 +39,21 @@ func (h *contactUsRepository) CreateContactUs(ctx context.Context, req entity.Co
 // FetchAllContactUs implements ContactUsInterface.
 func (h *contactUsRepository) FetchAllContactUs(ctx context.Context) ([]entity.ContactUsEntity, error) {
 	modelContactUs := []model.ContactUs{}
-	err = h.DB.Select("id", "location_name", "address", "phone_number", "company_name").Find(&modelContactUs).Order("created_at DESC").Error
+	err := dbConn(ctx, h.DB).Select("id", "location_name", "address", "phone_number", "company_name").Find(&modelContactUs).Order("created_at DESC").Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllContactUs - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllContactUs - 1")
+		return nil, dbError(err, "contact us")
+	}
+
+	var ids []int64
+	for _, v := range modelContactUs {
+		ids = append(ids, v.ID)
+	}
+
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypeContactUs, ids...)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllContactUs - 2")
+		return nil, dbError(err, "contact us")
 	}
 
 	var contactUsRepositoryEntities []entity.ContactUsEntity
@@ -50,8 +61,8 @@ func (h *contactUsRepository) FetchAllContactUs(ctx context.Context) ([]entity.C
 		contactUsRepositoryEntities = append(contactUsRepositoryEntities, entity.ContactUsEntity{
 			ID:           v.ID,
 			CompanyName:  v.CompanyName,
-			LocationName: v.LocationName,
-			Address:      v.Address,
+			LocationName: translations.value(v.ID, "location_name", v.LocationName),
+			Address:      translations.value(v.ID, "address", v.Address),
 			PhoneNumber:  v.PhoneNumber,
 		})
 	}
@@ -62,10 +73,10 @@ func (h *contactUsRepository) FetchAllContactUs(ctx context.Context) ([]entity.C
 // FetchByIDContactUs implements ContactUsInterface.
 func (h *contactUsRepository) FetchByIDContactUs(ctx context.Context, id int64) (*entity.ContactUsEntity, error) {
 	modelContactUs := model.ContactUs{}
-	err = h.DB.Select("id", "location_name", "address", "phone_number", "company_name").Where("id = ?", id).First(&modelContactUs).Error
+	err := dbConn(ctx, h.DB).Select("id", "location_name", "address", "phone_number", "company_name").Where("id = ?", id).First(&modelContactUs).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDContactUs - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDContactUs - 1")
+		return nil, dbError(err, "contact us")
 	}
 
 	return &entity.ContactUsEntity{
@@ -81,19 +92,19 @@ func (h *contactUsRepository) FetchByIDContactUs(ctx context.Context, id int64)
 func (h *contactUsRepository) EditByIDContactUs(ctx context.Context, req entity.ContactUsEntity) error {
 	modelContactUs := model.ContactUs{}
 
-	err = h.DB.Where("id =?", req.ID).First(&modelContactUs).Error
+	err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelContactUs).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDContactUs - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDContactUs - 1")
+		return dbError(err, "contact us")
 	}
 	modelContactUs.Address = req.Address
 	modelContactUs.CompanyName = req.CompanyName
 	modelContactUs.PhoneNumber = req.PhoneNumber
 	modelContactUs.LocationName = req.LocationName
-	err = h.DB.Save(&modelContactUs).Error
+	err = dbConn(ctx, h.DB).Save(&modelContactUs).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDContactUs - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDContactUs - 2")
+		return dbError(err, "contact us")
 	}
 	return nil
 }
@@ -102,16 +113,16 @@ func (h *contactUsRepository) EditByIDContactUs(ctx context.Context, req entity.
 func (h *contactUsRepository) DeleteByIDContactUs(ctx context.Context, id int64) error {
 	modelContactUs := model.ContactUs{}
 
-	err = h.DB.Where("id = ?", id).First(&modelContactUs).Error
+	err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelContactUs).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDContactUs - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDContactUs - 1")
+		return dbError(err, "contact us")
 	}
 
-	err = h.DB.Delete(&modelContactUs).Error
+	err = dbConn(ctx, h.DB).Delete(&modelContactUs).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDContactUs - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDContactUs - 2")
+		return dbError(err, "contact us")
 	}
 	return nil
 }
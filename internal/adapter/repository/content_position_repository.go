@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/conv"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type ContentPositionRepositoryInterface interface {
+	ReorderContent(ctx context.Context, contentType string, ids []int64) error
+}
+
+type contentPositionRepository struct {
+	DB *gorm.DB
+}
+
+// ReorderContent implements ContentPositionRepositoryInterface.
+// Positions follow the order of ids, which must list every live row exactly
+// once; the whole list is applied or nothing is.
+func (h *contentPositionRepository) ReorderContent(ctx context.Context, contentType string, ids []int64) error {
+	if !entity.IsSortableContentType(contentType) {
+		log.Ctx(ctx).Error().Err(conv.ErrBadParamInput).Msg("[REPOSITORY] ReorderContent - 1")
+		return conv.ErrBadParamInput
+	}
+
+	content, err := newContentModel(contentType)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] ReorderContent - 2")
+		return dbError(err, "content")
+	}
+
+	err = dbConn(ctx, h.DB).Transaction(func(tx *gorm.DB) error {
+		if err := lockPositions(tx, content); err != nil {
+			return err
+		}
+
+		var liveIDs []int64
+		if err := tx.Model(content).Pluck("id", &liveIDs).Error; err != nil {
+			return err
+		}
+
+		live := make(map[int64]bool, len(liveIDs))
+		for _, id := range liveIDs {
+			live[id] = true
+		}
+		if len(ids) != len(live) {
+			return conv.ErrReorderIncomplete
+		}
+		for _, id := range ids {
+			if !live[id] {
+				return conv.ErrReorderIncomplete
+			}
+			delete(live, id)
+		}
+
+		for i, id := range ids {
+			if err := tx.Model(content).Where("id = ?", id).Update("position", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] ReorderContent - 3")
+		return dbError(err, "content")
+	}
+	return nil
+}
+
+// createLast inserts row at the end of its list, position points at the
+// Position field of row.
+func createLast(db *gorm.DB, row interface{}, position *int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := lockPositions(tx, row); err != nil {
+			return err
+		}
+
+		var lastPosition int64
+		if err := tx.Model(row).Select("COALESCE(MAX(position), 0)").Scan(&lastPosition).Error; err != nil {
+			return err
+		}
+		*position = lastPosition + 1
+
+		return tx.Create(row).Error
+	})
+}
+
+// lockPositions serializes the changes to the positions of the table of
+// content until tx ends, so concurrent creates and reorders don't collide.
+func lockPositions(tx *gorm.DB, content interface{}) error {
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(content); err != nil {
+		return err
+	}
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "position:"+stmt.Schema.Table).Error
+}
+
+func NewContentPositionRepository(DB *gorm.DB) ContentPositionRepositoryInterface {
+	return &contentPositionRepository{
+		DB: DB,
+	}
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"encoding/json"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/model"
+	"latihan-compro/utils/conv"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// contentModels maps every content type to a constructor of its gorm model.
+var contentModels = map[string]func() interface{}{
+	entity.ContentTypeHeroSection:           func() interface{} { return &model.HeroSection{} },
+	entity.ContentTypeClientSection:         func() interface{} { return &model.ClientSection{} },
+	entity.ContentTypeAboutCompany:          func() interface{} { return &model.AboutCompany{} },
+	entity.ContentTypeAboutCompanyKeynote:   func() interface{} { return &model.AboutCompanyKeynote{} },
+	entity.ContentTypeFaqSection:            func() interface{} { return &model.FaqSection{} },
+	entity.ContentTypeOurTeam:               func() interface{} { return &model.OurTeam{} },
+	entity.ContentTypeServiceSection:        func() interface{} { return &model.ServiceSection{} },
+	entity.ContentTypeServiceDetail:         func() interface{} { return &model.ServiceDetail{} },
+	entity.ContentTypePortofolioSection:     func() interface{} { return &model.PortofolioSection{} },
+	entity.ContentTypePortofolioDetail:      func() interface{} { return &model.PortofolioDetail{} },
+	entity.ContentTypePortofolioTestimonial: func() interface{} { return &model.PortofolioTestimonial{} },
+	entity.ContentTypeContactUs:             func() interface{} { return &model.ContactUs{} },
+	entity.ContentTypeAppointment:           func() interface{} { return &model.Appointment{} },
+}
+
+type contentChild struct {
+	contentType string
+	column      string
+}
+
+// contentChildren lists, per content type, the content referencing it with
+// ON DELETE CASCADE, deleting a row for good deletes them too.
+var contentChildren = map[string][]contentChild{
+	entity.ContentTypeAboutCompany: {
+		{entity.ContentTypeAboutCompanyKeynote, "about_company_id"},
+	},
+	entity.ContentTypeServiceSection: {
+		{entity.ContentTypeServiceDetail, "service_id"},
+		{entity.ContentTypeAppointment, "service_id"},
+	},
+	entity.ContentTypePortofolioSection: {
+		{entity.ContentTypePortofolioDetail, "portofolio_section_id"},
+		{entity.ContentTypePortofolioTestimonial, "portofolio_section_id"},
+	},
+}
+
+// mediaColumns lists, per content type, the columns holding uploaded file urls.
+var mediaColumns = map[string][]string{
+	entity.ContentTypeHeroSection:           {"path_video", "path_banner"},
+	entity.ContentTypeClientSection:         {"path_icon"},
+	entity.ContentTypeAboutCompanyKeynote:   {"path_image"},
+	entity.ContentTypeOurTeam:               {"path_photo"},
+	entity.ContentTypeServiceSection:        {"path_icon"},
+	entity.ContentTypeServiceDetail:         {"path_image", "path_pdf", "path_docx"},
+	entity.ContentTypePortofolioSection:     {"thumbnail"},
+	entity.ContentTypePortofolioTestimonial: {"thumbnail"},
+}
+
+func newContentModel(contentType string) (interface{}, error) {
+	newModel, ok := contentModels[contentType]
+	if !ok {
+		return nil, conv.ErrBadParamInput
+	}
+	return newModel(), nil
+}
+
+// lockContent locks the row of the given content until tx ends.
+func lockContent(tx *gorm.DB, contentType string, contentID int64) error {
+	content, err := newContentModel(contentType)
+	if err != nil {
+		return err
+	}
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", contentID).First(content).Error
+}
+
+// snapshotContent returns the JSON encoded row of the given content.
+func snapshotContent(tx *gorm.DB, contentType string, contentID int64) (string, error) {
+	content, err := newContentModel(contentType)
+	if err != nil {
+		return "", err
+	}
+
+	if err = tx.Where("id = ?", contentID).First(content).Error; err != nil {
+		return "", err
+	}
+
+	snapshot, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	return string(snapshot), nil
+}
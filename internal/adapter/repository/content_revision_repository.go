@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/model"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ContentRevisionRepositoryInterface interface {
+	TrackRevision(ctx context.Context, req entity.ContentRevisionEntity, edit func(ctx context.Context) error) error
+	FetchAllRevision(ctx context.Context, contentType string, contentID int64) ([]entity.ContentRevisionEntity, error)
+	FetchByVersionRevision(ctx context.Context, contentType string, contentID, version int64) (*entity.ContentRevisionEntity, error)
+	RestoreRevision(ctx context.Context, req entity.ContentRevisionEntity) error
+}
+
+type contentRevisionRepository struct {
+	DB *gorm.DB
+}
+
+// TrackRevision implements ContentRevisionRepositoryInterface.
+// The content row is locked and edit runs in the same transaction as the
+// revisions, so concurrent edits get consecutive versions and an edit is
+// never saved without its revision. Content created before revisions existed
+// has no history yet, so its current state is kept as version 1 first.
+func (h *contentRevisionRepository) TrackRevision(ctx context.Context, req entity.ContentRevisionEntity, edit func(ctx context.Context) error) error {
+	err := dbConn(ctx, h.DB).Transaction(func(tx *gorm.DB) error {
+		if err := lockContent(tx, req.ContentType, req.ContentID); err != nil {
+			return err
+		}
+
+		var total int64
+		err := tx.Model(&model.ContentRevision{}).
+			Where("content_type = ? AND content_id = ?", req.ContentType, req.ContentID).
+			Count(&total).Error
+		if err != nil {
+			return err
+		}
+
+		if total == 0 {
+			err = h.createRevision(tx, entity.ContentRevisionEntity{
+				ContentType: req.ContentType,
+				ContentID:   req.ContentID,
+				Note:        "baseline",
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = edit(withTx(ctx, tx)); err != nil {
+			return err
+		}
+
+		return h.createRevision(tx, req)
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] TrackRevision - 1")
+		return dbError(err, "content revision")
+	}
+	return nil
+}
+
+// FetchAllRevision implements ContentRevisionRepositoryInterface.
+func (h *contentRevisionRepository) FetchAllRevision(ctx context.Context, contentType string, contentID int64) ([]entity.ContentRevisionEntity, error) {
+	modelRevisions := []model.ContentRevision{}
+	err := dbConn(ctx, h.DB).Where("content_type = ? AND content_id = ?", contentType, contentID).
+		Order("version DESC").
+		Find(&modelRevisions).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllRevision - 1")
+		return nil, dbError(err, "content revision")
+	}
+
+	var revisionEntities []entity.ContentRevisionEntity
+	for _, v := range modelRevisions {
+		revisionEntities = append(revisionEntities, toContentRevisionEntity(v))
+	}
+
+	return revisionEntities, nil
+}
+
+// FetchByVersionRevision implements ContentRevisionRepositoryInterface.
+func (h *contentRevisionRepository) FetchByVersionRevision(ctx context.Context, contentType string, contentID, version int64) (*entity.ContentRevisionEntity, error) {
+	modelRevision := model.ContentRevision{}
+	err := dbConn(ctx, h.DB).Where("content_type = ? AND content_id = ? AND version = ?", contentType, contentID, version).
+		First(&modelRevision).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByVersionRevision - 1")
+		return nil, dbError(err, "content revision")
+	}
+
+	revisionEntity := toContentRevisionEntity(modelRevision)
+	return &revisionEntity, nil
+}
+
+// restorePreservedFields keep their current value when a snapshot is restored,
+// so rolling back content never moves or resurrects a row.
+var restorePreservedFields = []string{"ID", "Position", "CreatedAt", "DeletedAt"}
+
+// RestoreRevision implements ContentRevisionRepositoryInterface.
+// The content row is overwritten with the snapshot of req.Version and the
+// restored state is recorded as a new version, so a restore can be undone.
+func (h *contentRevisionRepository) RestoreRevision(ctx context.Context, req entity.ContentRevisionEntity) error {
+	err := dbConn(ctx, h.DB).Transaction(func(tx *gorm.DB) error {
+		modelRevision := model.ContentRevision{}
+		err := tx.Where("content_type = ? AND content_id = ? AND version = ?", req.ContentType, req.ContentID, req.Version).
+			First(&modelRevision).Error
+		if err != nil {
+			return err
+		}
+
+		content, err := newContentModel(req.ContentType)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", req.ContentID).First(content).Error
+		if err != nil {
+			return err
+		}
+
+		snapshot := map[string]json.RawMessage{}
+		if err = json.Unmarshal([]byte(modelRevision.Snapshot), &snapshot); err != nil {
+			return err
+		}
+		for _, field := range restorePreservedFields {
+			delete(snapshot, field)
+		}
+
+		raw, err := json.Marshal(snapshot)
+		if err != nil {
+			return err
+		}
+
+		if err = json.Unmarshal(raw, content); err != nil {
+			return err
+		}
+
+		if err = tx.Save(content).Error; err != nil {
+			return err
+		}
+
+		req.Note = fmt.Sprintf("restored from version %d", req.Version)
+		return h.createRevision(tx, req)
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] RestoreRevision - 1")
+		return dbError(err, "content revision")
+	}
+	return nil
+}
+
+// createRevision snapshots the content row as its next version, the caller
+// holds the lock of the row so the version can't be taken concurrently.
+func (h *contentRevisionRepository) createRevision(tx *gorm.DB, req entity.ContentRevisionEntity) error {
+	snapshot, err := snapshotContent(tx, req.ContentType, req.ContentID)
+	if err != nil {
+		return err
+	}
+
+	var lastVersion int64
+	err = tx.Model(&model.ContentRevision{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("content_type = ? AND content_id = ?", req.ContentType, req.ContentID).
+		Scan(&lastVersion).Error
+	if err != nil {
+		return err
+	}
+
+	modelRevision := model.ContentRevision{
+		ContentType: req.ContentType,
+		ContentID:   req.ContentID,
+		Version:     lastVersion + 1,
+		Snapshot:    snapshot,
+	}
+	if req.AuthorID != 0 {
+		modelRevision.AuthorID = &req.AuthorID
+	}
+	if req.Note != "" {
+		modelRevision.Note = &req.Note
+	}
+
+	return tx.Create(&modelRevision).Error
+}
+
+func toContentRevisionEntity(v model.ContentRevision) entity.ContentRevisionEntity {
+	revisionEntity := entity.ContentRevisionEntity{
+		ID:          v.ID,
+		ContentType: v.ContentType,
+		ContentID:   v.ContentID,
+		Version:     v.Version,
+		Snapshot:    v.Snapshot,
+		CreatedAt:   v.CreatedAt,
+	}
+	if v.AuthorID != nil {
+		revisionEntity.AuthorID = *v.AuthorID
+	}
+	if v.Note != nil {
+		revisionEntity.Note = *v.Note
+	}
+	return revisionEntity
+}
+
+func NewContentRevisionRepository(DB *gorm.DB) ContentRevisionRepositoryInterface {
+	return &contentRevisionRepository{
+		DB: DB,
+	}
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/model"
+	"latihan-compro/utils/conv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ContentTranslationRepositoryInterface interface {
+	FetchAllTranslation(ctx context.Context, contentType string, contentID int64) ([]entity.ContentTranslationEntity, error)
+	UpsertTranslation(ctx context.Context, contentType string, contentID int64, locale string, fields map[string]string) error
+	DeleteTranslation(ctx context.Context, contentType string, contentID int64, locale string) error
+	FetchMissingTranslation(ctx context.Context, locales []string) ([]entity.MissingTranslationEntity, error)
+}
+
+type contentTranslationRepository struct {
+	DB *gorm.DB
+}
+
+// FetchAllTranslation implements ContentTranslationRepositoryInterface.
+func (h *contentTranslationRepository) FetchAllTranslation(ctx context.Context, contentType string, contentID int64) ([]entity.ContentTranslationEntity, error) {
+	modelTranslations := []model.ContentTranslation{}
+	err := dbConn(ctx, h.DB).Where("content_type = ? AND content_id = ?", contentType, contentID).
+		Order("locale ASC, field ASC").
+		Find(&modelTranslations).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllTranslation - 1")
+		return nil, dbError(err, "content translation")
+	}
+
+	var translationEntities []entity.ContentTranslationEntity
+	for _, val := range modelTranslations {
+		updatedAt := val.CreatedAt
+		if val.UpdatedAt != nil {
+			updatedAt = *val.UpdatedAt
+		}
+
+		translationEntities = append(translationEntities, entity.ContentTranslationEntity{
+			ID:          val.ID,
+			ContentType: val.ContentType,
+			ContentID:   val.ContentID,
+			Locale:      val.Locale,
+			Field:       val.Field,
+			Value:       val.Value,
+			UpdatedAt:   updatedAt,
+		})
+	}
+
+	return translationEntities, nil
+}
+
+// UpsertTranslation implements ContentTranslationRepositoryInterface.
+func (h *contentTranslationRepository) UpsertTranslation(ctx context.Context, contentType string, contentID int64, locale string, fields map[string]string) error {
+	content, err := newContentModel(contentType)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] UpsertTranslation - 1")
+		return dbError(err, "content translation")
+	}
+
+	var total int64
+	if err = dbConn(ctx, h.DB).Model(content).Where("id = ?", contentID).Count(&total).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] UpsertTranslation - 2")
+		return dbError(err, "content translation")
+	}
+
+	if total == 0 {
+		log.Ctx(ctx).Error().Err(conv.ErrNotFound).Msg("[REPOSITORY] UpsertTranslation - 3")
+		return conv.ErrNotFound
+	}
+
+	now := time.Now()
+	modelTranslations := []model.ContentTranslation{}
+	for field, value := range fields {
+		modelTranslations = append(modelTranslations, model.ContentTranslation{
+			ContentType: contentType,
+			ContentID:   contentID,
+			Locale:      locale,
+			Field:       field,
+			Value:       value,
+			UpdatedAt:   &now,
+		})
+	}
+
+	err = dbConn(ctx, h.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "content_type"}, {Name: "content_id"}, {Name: "locale"}, {Name: "field"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+	}).Create(&modelTranslations).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] UpsertTranslation - 4")
+		return dbError(err, "content translation")
+	}
+	return nil
+}
+
+// DeleteTranslation implements ContentTranslationRepositoryInterface.
+func (h *contentTranslationRepository) DeleteTranslation(ctx context.Context, contentType string, contentID int64, locale string) error {
+	result := dbConn(ctx, h.DB).Where("content_type = ? AND content_id = ? AND locale = ?", contentType, contentID, locale).
+		Delete(&model.ContentTranslation{})
+	if result.Error != nil {
+		log.Ctx(ctx).Error().Err(result.Error).Msg("[REPOSITORY] DeleteTranslation - 1")
+		return dbError(result.Error, "content translation")
+	}
+
+	if result.RowsAffected == 0 {
+		log.Ctx(ctx).Error().Err(conv.ErrNotFound).Msg("[REPOSITORY] DeleteTranslation - 2")
+		return conv.ErrNotFound
+	}
+	return nil
+}
+
+// FetchMissingTranslation implements ContentTranslationRepositoryInterface.
+// It reports, per live row and locale, the translatable fields without a value.
+func (h *contentTranslationRepository) FetchMissingTranslation(ctx context.Context, locales []string) ([]entity.MissingTranslationEntity, error) {
+	var missingEntities []entity.MissingTranslationEntity
+	for _, contentType := range entity.ContentTypes {
+		fields, ok := entity.TranslatableFields[contentType]
+		if !ok {
+			continue
+		}
+
+		content, err := newContentModel(contentType)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchMissingTranslation - 1")
+			return nil, dbError(err, "content translation")
+		}
+
+		var ids []int64
+		if err = dbConn(ctx, h.DB).Model(content).Order("id ASC").Pluck("id", &ids).Error; err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchMissingTranslation - 2")
+			return nil, dbError(err, "content translation")
+		}
+
+		if len(ids) == 0 {
+			continue
+		}
+
+		for _, locale := range locales {
+			translations, err := fetchTranslations(dbConn(ctx, h.DB), contentType, locale, ids)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchMissingTranslation - 3")
+				return nil, dbError(err, "content translation")
+			}
+
+			for _, id := range ids {
+				var missingFields []string
+				for _, field := range fields {
+					if translations[id][field] == "" {
+						missingFields = append(missingFields, field)
+					}
+				}
+
+				if len(missingFields) == 0 {
+					continue
+				}
+
+				missingEntities = append(missingEntities, entity.MissingTranslationEntity{
+					ContentType: contentType,
+					ContentID:   id,
+					Locale:      locale,
+					Fields:      missingFields,
+				})
+			}
+		}
+	}
+
+	return missingEntities, nil
+}
+
+// translationSet holds translated values keyed by content id, then column.
+type translationSet map[int64]map[string]string
+
+// value returns the translated field, or fallback when it is untranslated.
+func (t translationSet) value(id int64, field, fallback string) string {
+	if translated := t[id][field]; translated != "" {
+		return translated
+	}
+	return fallback
+}
+
+func fetchTranslations(db *gorm.DB, contentType, locale string, ids []int64) (translationSet, error) {
+	translations := translationSet{}
+	if locale == "" || len(ids) == 0 {
+		return translations, nil
+	}
+
+	modelTranslations := []model.ContentTranslation{}
+	err := db.Select("content_id", "field", "value").
+		Where("content_type = ? AND locale = ? AND content_id IN ?", contentType, locale, ids).
+		Find(&modelTranslations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, val := range modelTranslations {
+		if translations[val.ContentID] == nil {
+			translations[val.ContentID] = map[string]string{}
+		}
+		translations[val.ContentID][val.Field] = val.Value
+	}
+	return translations, nil
+}
+
+// contentTranslations loads the translations of the given rows in the locale
+// negotiated for the request. It is empty for the default locale, so every
+// field falls back to the value stored on the row.
+func contentTranslations(ctx context.Context, db *gorm.DB, contentType string, ids ...int64) (translationSet, error) {
+	return fetchTranslations(dbConn(ctx, db), contentType, conv.GetLocaleByCtx(ctx), ids)
+}
+
+func NewContentTranslationRepository(DB *gorm.DB) ContentTranslationRepositoryInterface {
+	return &contentTranslationRepository{
+		DB: DB,
+	}
+}
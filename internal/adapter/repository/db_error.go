@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"errors"
+	"latihan-compro/internal/core/domain/errs"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// Postgres error codes translated by dbError, see
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+	pgNotNullViolation    = "23502"
+	pgCheckViolation      = "23514"
+	pgStringTooLong       = "22001"
+	pgNumericOutOfRange   = "22003"
+	pgInvalidDatetime     = "22007"
+	pgDatetimeOutOfRange  = "22008"
+	pgInvalidText         = "22P02"
+)
+
+// dbError translates a GORM or pgx error about resource into an errs.Error,
+// the codes are derived from resource, e.g. hero_section_not_found. Errors
+// that already carry a kind and unknown errors are returned as is.
+func dbError(err error, resource string) error {
+	var domainErr *errs.Error
+	if err == nil || errors.As(err, &domainErr) {
+		return err
+	}
+
+	code := strings.ReplaceAll(resource, " ", "_")
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errs.Wrap(err, errs.KindNotFound, code+"_not_found", resource+" not found")
+	}
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return errs.Wrap(err, errs.KindConflict, code+"_already_exists", resource+" already exists")
+	}
+	if errors.Is(err, gorm.ErrForeignKeyViolated) {
+		return errs.Wrap(err, errs.KindConflict, code+"_reference_conflict", resource+" conflicts with related data")
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return errs.Wrap(err, errs.KindConflict, code+"_already_exists", resource+" already exists")
+	case pgForeignKeyViolation:
+		return errs.Wrap(err, errs.KindConflict, code+"_reference_conflict", resource+" conflicts with related data")
+	case pgNotNullViolation, pgCheckViolation, pgStringTooLong, pgNumericOutOfRange,
+		pgInvalidDatetime, pgDatetimeOutOfRange, pgInvalidText:
+		return errs.Wrap(err, errs.KindValidation, code+"_invalid", resource+" is not valid")
+	default:
+		return err
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -28,9 +28,9 @@ func (h *faqSectionRepository) CreateFaqSection(ctx context.Context, req entity.
 		Title:       req.Title,
 	}
 
-	if err = h.DB.Create(&modelFaqSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateFaqSection - 1: %v", err)
-		return err
+	if err := createLast(dbConn(ctx, h.DB), &modelFaqSection, &modelFaqSection.Position); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateFaqSection - 1")
+		return dbError(err, "faq section")
 	}
 	return nil
 }
@@ -39,16 +39,16 @@ func (h *faqSectionRepository) CreateFaqSection(ctx context.Context, req entity.
 func (h *faqSectionRepository) DeleteByIDFaqSection(ctx context.Context, id int64) error {
 	modelFaqSection := model.FaqSection{}
 
-	err = h.DB.Where("id = ?", id).First(&modelFaqSection).Error
+	err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelFaqSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDFaqSection - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDFaqSection - 1")
+		return dbError(err, "faq section")
 	}
 
-	err = h.DB.Delete(&modelFaqSection).Error
+	err = dbConn(ctx, h.DB).Delete(&modelFaqSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDFaqSection - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDFaqSection - 2")
+		return dbError(err, "faq section")
 	}
 	return nil
 }
@@ -57,18 +57,18 @@ func (h *faqSectionRepository) DeleteByIDFaqSection(ctx context.Context, id int6
 func (h *faqSectionRepository) EditByIDFaqSection(ctx context.Context, req entity.FaqSectionEntity) error {
 	modelFaqSection := model.FaqSection{}
 
-	err = h.DB.Where("id =?", req.ID).First(&modelFaqSection).Error
+	err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelFaqSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDFaqSection - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDFaqSection - 1")
+		return dbError(err, "faq section")
 	}
 	modelFaqSection.Description = req.Description
 	modelFaqSection.Title = req.Title
 
-	err = h.DB.Save(&modelFaqSection).Error
+	err = dbConn(ctx, h.DB).Save(&modelFaqSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDFaqSection - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDFaqSection - 2")
+		return dbError(err, "faq section")
 	}
 	return nil
 }
@@ -76,18 +76,30 @@ func (h *faqSectionRepository) EditByIDFaqSection(ctx context.Context, req entit
 // FetchAllFaqSection implements FaqSectionInterface.
 func (h *faqSectionRepository) FetchAllFaqSection(ctx context.Context) ([]entity.FaqSectionEntity, error) {
 	modelFaqSection := []model.FaqSection{}
-	err = h.DB.Select("id", "title", "description").Find(&modelFaqSection).Order("created_at DESC").Error
+	err := dbConn(ctx, h.DB).Select("id", "title", "description", "position").Order("position ASC, id ASC").Find(&modelFaqSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllFaqSection - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllFaqSection - 1")
+		return nil, dbError(err, "faq section")
+	}
+
+	var ids []int64
+	for _, v := range modelFaqSection {
+		ids = append(ids, v.ID)
+	}
+
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypeFaqSection, ids...)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllFaqSection - 2")
+		return nil, dbError(err, "faq section")
 	}
 
 	var faqSectionRepositoryEntities []entity.FaqSectionEntity
 	for _, v := range modelFaqSection {
 		faqSectionRepositoryEntities = append(faqSectionRepositoryEntities, entity.FaqSectionEntity{
 			ID:          v.ID,
-			Description: v.Description,
-			Title:       v.Title,
+			Position:    v.Position,
+			Description: translations.value(v.ID, "description", v.Description),
+			Title:       translations.value(v.ID, "title", v.Title),
 		})
 	}
 
@@ -97,14 +109,15 @@ func (h *faqSectionRepository) FetchAllFaqSection(ctx context.Context) ([]entity
 // FetchByIDFaqSection implements FaqSectionInterface.
 func (h *faqSectionRepository) FetchByIDFaqSection(ctx context.Context, id int64) (*entity.FaqSectionEntity, error) {
 	modelFaqSection := model.FaqSection{}
-	err = h.DB.Select("id", "title", "description").Where("id = ?", id).First(&modelFaqSection).Error
+	err := dbConn(ctx, h.DB).Select("id", "title", "description", "position").Where("id = ?", id).First(&modelFaqSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDFaqSection - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDFaqSection - 1")
+		return nil, dbError(err, "faq section")
 	}
 
 	return &entity.FaqSectionEntity{
 		ID:          modelFaqSection.ID,
+		Position:    modelFaqSection.Position,
 		Description: modelFaqSection.Description,
 		Title:       modelFaqSection.Title,
 	}, nil
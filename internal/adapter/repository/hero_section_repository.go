@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -30,9 +30,9 @@ func (h *heroSection) CreateHeroSection(ctx context.Context, req entity.HeroSect
 		PathBanner: req.Banner,
 	}
 
-	if err = h.DB.Create(&modelHeroSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateHeroSection - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Create(&modelHeroSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateHeroSection - 1")
+		return dbError(err, "hero section")
 	}
 	return nil
 }
@@ -40,19 +40,30 @@ func (h *heroSection) CreateHeroSection(ctx context.Context, req entity.HeroSect
 // FetchAllHeroSection implements HeroSectionInterface.
 func (h *heroSection) FetchAllHeroSection(ctx context.Context) ([]entity.HeroSectionEntity, error) {
 	modelHeroSection := []model.HeroSection{}
-	err = h.DB.Select("id", "heading", "sub_heading", "path_video", "path_banner").Find(&modelHeroSection).Order("created_at DESC").Error
+	err := dbConn(ctx, h.DB).Select("id", "heading", "sub_heading", "path_video", "path_banner").Find(&modelHeroSection).Order("created_at DESC").Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllHeroSection - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllHeroSection - 1")
+		return nil, dbError(err, "hero section")
+	}
+
+	var ids []int64
+	for _, v := range modelHeroSection {
+		ids = append(ids, v.ID)
+	}
+
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypeHeroSection, ids...)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllHeroSection - 2")
+		return nil, dbError(err, "hero section")
 	}
 
 	var heroSectionEntities []entity.HeroSectionEntity
 	for _, v := range modelHeroSection {
 		heroSectionEntities = append(heroSectionEntities, entity.HeroSectionEntity{
 			ID:         v.ID,
-			Heading:    v.Heading,
-			SubHeading: v.SubHeading,
-			PathVideo:  *v.PathVideo,
+			Heading:    translations.value(v.ID, "heading", v.Heading),
+			SubHeading: translations.value(v.ID, "sub_heading", v.SubHeading),
+			PathVideo:  stringValue(v.PathVideo),
 			Banner:     v.PathBanner,
 		})
 	}
@@ -63,17 +74,17 @@ func (h *heroSection) FetchAllHeroSection(ctx context.Context) ([]entity.HeroSec
 // FetchByIDHeroSection implements HeroSectionInterface.
 func (h *heroSection) FetchByIDHeroSection(ctx context.Context, id int64) (*entity.HeroSectionEntity, error) {
 	modelHeroSection := model.HeroSection{}
-	err = h.DB.Where("id = ?", id).First(&modelHeroSection).Error
+	err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelHeroSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDHeroSection - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDHeroSection - 1")
+		return nil, dbError(err, "hero section")
 	}
 
 	return &entity.HeroSectionEntity{
 		ID:         modelHeroSection.ID,
 		Heading:    modelHeroSection.Heading,
 		SubHeading: modelHeroSection.SubHeading,
-		PathVideo:  *modelHeroSection.PathVideo,
+		PathVideo:  stringValue(modelHeroSection.PathVideo),
 		Banner:     modelHeroSection.PathBanner,
 	}, nil
 }
@@ -82,19 +93,19 @@ func (h *heroSection) FetchByIDHeroSection(ctx context.Context, id int64) (*enti
 func (h *heroSection) EditByIDHeroSection(ctx context.Context, req entity.HeroSectionEntity) error {
 	modelHeroSection := model.HeroSection{}
 
-	err = h.DB.Where("id =?", req.ID).First(&modelHeroSection).Error
+	err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelHeroSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDHeroSection - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDHeroSection - 1")
+		return dbError(err, "hero section")
 	}
 	modelHeroSection.Heading = req.Heading
 	modelHeroSection.SubHeading = req.SubHeading
 	modelHeroSection.PathVideo = &req.PathVideo
 	modelHeroSection.PathBanner = req.Banner
-	err = h.DB.Save(&modelHeroSection).Error
+	err = dbConn(ctx, h.DB).Save(&modelHeroSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDHeroSection - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDHeroSection - 2")
+		return dbError(err, "hero section")
 	}
 	return nil
 }
@@ -103,20 +114,28 @@ func (h *heroSection) EditByIDHeroSection(ctx context.Context, req entity.HeroSe
 func (h *heroSection) DeleteByIDHeroSection(ctx context.Context, id int64) error {
 	modelHeroSection := model.HeroSection{}
 
-	err = h.DB.Where("id = ?", id).First(&modelHeroSection).Error
+	err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelHeroSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDHeroSection - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDHeroSection - 1")
+		return dbError(err, "hero section")
 	}
 
-	err = h.DB.Delete(&modelHeroSection).Error
+	err = dbConn(ctx, h.DB).Delete(&modelHeroSection).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDHeroSection - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDHeroSection - 2")
+		return dbError(err, "hero section")
 	}
 	return nil
 }
 
+// stringValue returns the value of a nullable column, empty when it is NULL.
+func stringValue(val *string) string {
+	if val == nil {
+		return ""
+	}
+	return *val
+}
+
 func NewHeroSectionRepository(DB *gorm.DB) HeroSectionInterface {
 	return &heroSection{
 		DB: DB,
@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/model"
+	"latihan-compro/utils/conv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+type MediaAssetRepositoryInterface interface {
+	CreateMediaAsset(ctx context.Context, req entity.MediaAssetEntity) (int64, error)
+	FetchAllMediaAsset(ctx context.Context, filter entity.MediaAssetFilterEntity) ([]entity.MediaAssetEntity, int64, error)
+	FetchByIDMediaAsset(ctx context.Context, id int64) (*entity.MediaAssetEntity, error)
+	FetchByUrlMediaAsset(ctx context.Context, url string) (*entity.MediaAssetEntity, error)
+	EditAltTextMediaAsset(ctx context.Context, id int64, altText string) error
+	DeleteByIDMediaAsset(ctx context.Context, id int64) error
+	FetchMediaAssetReference(ctx context.Context, url string) ([]entity.MediaAssetReferenceEntity, error)
+	FetchOrphanMediaAsset(ctx context.Context) ([]entity.MediaAssetEntity, error)
+}
+
+type mediaAssetRepository struct {
+	DB *gorm.DB
+}
+
+// CreateMediaAsset implements MediaAssetRepositoryInterface.
+func (h *mediaAssetRepository) CreateMediaAsset(ctx context.Context, req entity.MediaAssetEntity) (int64, error) {
+	variantPaths, err := json.Marshal(req.VariantPaths)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateMediaAsset - 1")
+		return 0, dbError(err, "media asset")
+	}
+
+	modelMediaAsset := model.MediaAsset{
+		Path:         req.Path,
+		Url:          req.Url,
+		ContentType:  req.ContentType,
+		Size:         req.Size,
+		VariantPaths: string(variantPaths),
+	}
+	if req.Width > 0 && req.Height > 0 {
+		modelMediaAsset.Width = &req.Width
+		modelMediaAsset.Height = &req.Height
+	}
+	if req.UploaderID != 0 {
+		modelMediaAsset.UploaderID = &req.UploaderID
+	}
+	if req.AltText != "" {
+		modelMediaAsset.AltText = &req.AltText
+	}
+
+	if err = dbConn(ctx, h.DB).Create(&modelMediaAsset).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateMediaAsset - 2")
+		return 0, dbError(err, "media asset")
+	}
+	return modelMediaAsset.ID, nil
+}
+
+// FetchAllMediaAsset implements MediaAssetRepositoryInterface.
+func (h *mediaAssetRepository) FetchAllMediaAsset(ctx context.Context, filter entity.MediaAssetFilterEntity) ([]entity.MediaAssetEntity, int64, error) {
+	query := dbConn(ctx, h.DB).Model(&model.MediaAsset{})
+	if filter.Search != "" {
+		search := "%" + filter.Search + "%"
+		query = query.Where("path ILIKE ? OR alt_text ILIKE ?", search, search)
+	}
+	if filter.ContentType != "" {
+		query = query.Where("content_type LIKE ?", filter.ContentType+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllMediaAsset - 1")
+		return nil, 0, dbError(err, "media asset")
+	}
+
+	modelMediaAssets := []model.MediaAsset{}
+	err := query.Order("created_at DESC").
+		Offset((filter.Page - 1) * filter.PerPage).
+		Limit(filter.PerPage).
+		Find(&modelMediaAssets).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllMediaAsset - 2")
+		return nil, 0, dbError(err, "media asset")
+	}
+
+	var mediaAssetEntities []entity.MediaAssetEntity
+	for _, v := range modelMediaAssets {
+		mediaAssetEntities = append(mediaAssetEntities, toMediaAssetEntity(v))
+	}
+
+	return mediaAssetEntities, total, nil
+}
+
+// FetchByIDMediaAsset implements MediaAssetRepositoryInterface.
+func (h *mediaAssetRepository) FetchByIDMediaAsset(ctx context.Context, id int64) (*entity.MediaAssetEntity, error) {
+	modelMediaAsset := model.MediaAsset{}
+	err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelMediaAsset).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDMediaAsset - 1")
+		return nil, dbError(err, "media asset")
+	}
+
+	mediaAssetEntity := toMediaAssetEntity(modelMediaAsset)
+	return &mediaAssetEntity, nil
+}
+
+// FetchByUrlMediaAsset implements MediaAssetRepositoryInterface.
+func (h *mediaAssetRepository) FetchByUrlMediaAsset(ctx context.Context, url string) (*entity.MediaAssetEntity, error) {
+	modelMediaAsset := model.MediaAsset{}
+	err := dbConn(ctx, h.DB).Where("url = ?", url).First(&modelMediaAsset).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByUrlMediaAsset - 1")
+		return nil, dbError(err, "media asset")
+	}
+
+	mediaAssetEntity := toMediaAssetEntity(modelMediaAsset)
+	return &mediaAssetEntity, nil
+}
+
+// EditAltTextMediaAsset implements MediaAssetRepositoryInterface.
+func (h *mediaAssetRepository) EditAltTextMediaAsset(ctx context.Context, id int64, altText string) error {
+	result := dbConn(ctx, h.DB).Model(&model.MediaAsset{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"alt_text": altText, "updated_at": time.Now()})
+	if result.Error != nil {
+		log.Ctx(ctx).Error().Err(result.Error).Msg("[REPOSITORY] EditAltTextMediaAsset - 1")
+		return dbError(result.Error, "media asset")
+	}
+
+	if result.RowsAffected == 0 {
+		log.Ctx(ctx).Error().Err(conv.ErrNotFound).Msg("[REPOSITORY] EditAltTextMediaAsset - 2")
+		return conv.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteByIDMediaAsset implements MediaAssetRepositoryInterface.
+func (h *mediaAssetRepository) DeleteByIDMediaAsset(ctx context.Context, id int64) error {
+	result := dbConn(ctx, h.DB).Where("id = ?", id).Delete(&model.MediaAsset{})
+	if result.Error != nil {
+		log.Ctx(ctx).Error().Err(result.Error).Msg("[REPOSITORY] DeleteByIDMediaAsset - 1")
+		return dbError(result.Error, "media asset")
+	}
+
+	if result.RowsAffected == 0 {
+		log.Ctx(ctx).Error().Err(conv.ErrNotFound).Msg("[REPOSITORY] DeleteByIDMediaAsset - 2")
+		return conv.ErrNotFound
+	}
+	return nil
+}
+
+// FetchMediaAssetReference implements MediaAssetRepositoryInterface.
+// Rows in the trash count as references too, as they may be restored.
+func (h *mediaAssetRepository) FetchMediaAssetReference(ctx context.Context, url string) ([]entity.MediaAssetReferenceEntity, error) {
+	var references []entity.MediaAssetReferenceEntity
+	for _, contentType := range entity.ContentTypes {
+		for _, column := range mediaColumns[contentType] {
+			content, err := newContentModel(contentType)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchMediaAssetReference - 1")
+				return nil, dbError(err, "media asset")
+			}
+
+			var ids []int64
+			err = dbConn(ctx, h.DB).Unscoped().Model(content).Where(column+" = ?", url).Pluck("id", &ids).Error
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchMediaAssetReference - 2")
+				return nil, dbError(err, "media asset")
+			}
+
+			for _, id := range ids {
+				references = append(references, entity.MediaAssetReferenceEntity{
+					ContentType: contentType,
+					ContentID:   id,
+					Field:       column,
+				})
+			}
+		}
+	}
+
+	return references, nil
+}
+
+// FetchOrphanMediaAsset implements MediaAssetRepositoryInterface.
+// It returns the assets whose url no content column holds.
+func (h *mediaAssetRepository) FetchOrphanMediaAsset(ctx context.Context) ([]entity.MediaAssetEntity, error) {
+	referenced := map[string]bool{}
+	for _, contentType := range entity.ContentTypes {
+		for _, column := range mediaColumns[contentType] {
+			content, err := newContentModel(contentType)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchOrphanMediaAsset - 1")
+				return nil, dbError(err, "media asset")
+			}
+
+			var urls []string
+			err = dbConn(ctx, h.DB).Unscoped().Model(content).
+				Where(column+" IS NOT NULL AND "+column+" <> ''").
+				Distinct().
+				Pluck(column, &urls).Error
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchOrphanMediaAsset - 2")
+				return nil, dbError(err, "media asset")
+			}
+
+			for _, url := range urls {
+				referenced[url] = true
+			}
+		}
+	}
+
+	modelMediaAssets := []model.MediaAsset{}
+	if err := dbConn(ctx, h.DB).Order("created_at ASC").Find(&modelMediaAssets).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchOrphanMediaAsset - 3")
+		return nil, dbError(err, "media asset")
+	}
+
+	var mediaAssetEntities []entity.MediaAssetEntity
+	for _, v := range modelMediaAssets {
+		if referenced[v.Url] {
+			continue
+		}
+		mediaAssetEntities = append(mediaAssetEntities, toMediaAssetEntity(v))
+	}
+
+	return mediaAssetEntities, nil
+}
+
+func toMediaAssetEntity(v model.MediaAsset) entity.MediaAssetEntity {
+	mediaAsset := entity.MediaAssetEntity{
+		ID:          v.ID,
+		Path:        v.Path,
+		Url:         v.Url,
+		ContentType: v.ContentType,
+		Size:        v.Size,
+		CreatedAt:   v.CreatedAt,
+	}
+	if v.Width != nil && v.Height != nil {
+		mediaAsset.Width = *v.Width
+		mediaAsset.Height = *v.Height
+	}
+	if v.UploaderID != nil {
+		mediaAsset.UploaderID = *v.UploaderID
+	}
+	if v.AltText != nil {
+		mediaAsset.AltText = *v.AltText
+	}
+	if err := json.Unmarshal([]byte(v.VariantPaths), &mediaAsset.VariantPaths); err != nil {
+		log.Error().Err(err).Msg("[REPOSITORY] toMediaAssetEntity - 1")
+	}
+	return mediaAsset
+}
+
+func NewMediaAssetRepository(DB *gorm.DB) MediaAssetRepositoryInterface {
+	return &mediaAssetRepository{
+		DB: DB,
+	}
+}
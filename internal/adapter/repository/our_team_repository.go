@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -29,9 +29,9 @@ func (h *ourTeamRepository) CreateOurTeam(ctx context.Context, req entity.OurTea
 		Tagline:   req.Tagline,
 	}
 
-	if err = h.DB.Create(&modelOurTeam).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateOurTeam - 1: %v", err)
-		return err
+	if err := createLast(dbConn(ctx, h.DB), &modelOurTeam, &modelOurTeam.Position); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateOurTeam - 1")
+		return dbError(err, "our team")
 	}
 	return nil
 }
@@ -40,16 +40,16 @@ func (h *ourTeamRepository) CreateOurTeam(ctx context.Context, req entity.OurTea
 func (h *ourTeamRepository) DeleteByIDOurTeam(ctx context.Context, id int64) error {
 	modelOurTeam := model.OurTeam{}
 
-	err = h.DB.Where("id = ?", id).First(&modelOurTeam).Error
+	err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelOurTeam).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDOurTeam - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDOurTeam - 1")
+		return dbError(err, "our team")
 	}
 
-	err = h.DB.Delete(&modelOurTeam).Error
+	err = dbConn(ctx, h.DB).Delete(&modelOurTeam).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDOurTeam - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDOurTeam - 2")
+		return dbError(err, "our team")
 	}
 	return nil
 }
@@ -58,19 +58,19 @@ func (h *ourTeamRepository) DeleteByIDOurTeam(ctx context.Context, id int64) err
 func (h *ourTeamRepository) EditByIDOurTeam(ctx context.Context, req entity.OurTeamEntity) error {
 	modelOurTeam := model.OurTeam{}
 
-	err = h.DB.Where("id =?", req.ID).First(&modelOurTeam).Error
+	err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelOurTeam).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDOurTeam - 1: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDOurTeam - 1")
+		return dbError(err, "our team")
 	}
 	modelOurTeam.Name = req.Name
 	modelOurTeam.Role = req.Role
 	modelOurTeam.PathPhoto = req.PathPhoto
 	modelOurTeam.Tagline = req.Tagline
-	err = h.DB.Save(&modelOurTeam).Error
+	err = dbConn(ctx, h.DB).Save(&modelOurTeam).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] EditByIDOurTeam - 2: %v", err)
-		return err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDOurTeam - 2")
+		return dbError(err, "our team")
 	}
 	return nil
 }
@@ -78,20 +78,32 @@ func (h *ourTeamRepository) EditByIDOurTeam(ctx context.Context, req entity.OurT
 // FetchAllOurTeam implements OurTeamInterface.
 func (h *ourTeamRepository) FetchAllOurTeam(ctx context.Context) ([]entity.OurTeamEntity, error) {
 	modelOurTeam := []model.OurTeam{}
-	err = h.DB.Select("id", "name", "role", "path_photo", "tagline").Find(&modelOurTeam).Order("created_at DESC").Error
+	err := dbConn(ctx, h.DB).Select("id", "name", "role", "path_photo", "tagline", "position").Order("position ASC, id ASC").Find(&modelOurTeam).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllOurTeam - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllOurTeam - 1")
+		return nil, dbError(err, "our team")
+	}
+
+	var ids []int64
+	for _, v := range modelOurTeam {
+		ids = append(ids, v.ID)
+	}
+
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypeOurTeam, ids...)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllOurTeam - 2")
+		return nil, dbError(err, "our team")
 	}
 
 	var ourTeamRepositoryEntities []entity.OurTeamEntity
 	for _, v := range modelOurTeam {
 		ourTeamRepositoryEntities = append(ourTeamRepositoryEntities, entity.OurTeamEntity{
 			ID:        v.ID,
+			Position:  v.Position,
 			Name:      v.Name,
 			PathPhoto: v.PathPhoto,
-			Tagline:   v.Tagline,
-			Role:      v.Role,
+			Tagline:   translations.value(v.ID, "tagline", v.Tagline),
+			Role:      translations.value(v.ID, "role", v.Role),
 		})
 	}
 
@@ -101,14 +113,15 @@ func (h *ourTeamRepository) FetchAllOurTeam(ctx context.Context) ([]entity.OurTe
 // FetchByIDOurTeam implements OurTeamInterface.
 func (h *ourTeamRepository) FetchByIDOurTeam(ctx context.Context, id int64) (*entity.OurTeamEntity, error) {
 	modelOurTeam := model.OurTeam{}
-	err = h.DB.Select("id", "name", "role", "path_photo", "tagline").Where("id = ?", id).First(&modelOurTeam).Error
+	err := dbConn(ctx, h.DB).Select("id", "name", "role", "path_photo", "tagline", "position").Where("id = ?", id).First(&modelOurTeam).Error
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDOurTeam - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDOurTeam - 1")
+		return nil, dbError(err, "our team")
 	}
 
 	return &entity.OurTeamEntity{
 		ID:        modelOurTeam.ID,
+		Position:  modelOurTeam.Position,
 		Name:      modelOurTeam.Name,
 		PathPhoto: modelOurTeam.PathPhoto,
 		Tagline:   modelOurTeam.Tagline,
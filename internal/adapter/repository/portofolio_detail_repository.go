@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -34,16 +34,16 @@ func (h *portofolioDetailRepository) CreatePortofolioDetail(ctx context.Context,
 		Description:         req.Description,
 	}
 
-	if err = h.DB.Create(&modelPortofolioDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreatePortofolioDetail - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Create(&modelPortofolioDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreatePortofolioDetail - 1")
+		return dbError(err, "portofolio detail")
 	}
 	return nil
 }
 
 // FetchAllPortofolioDetail implements PortofolioDetailInterface.
 func (h *portofolioDetailRepository) FetchAllPortofolioDetail(ctx context.Context) ([]entity.PortofolioDetailEntity, error) {
-	rows, err := h.DB.
+	rows, err := dbConn(ctx, h.DB).
 		Table("portofolio_details as pd").
 		Select("pd.id", "pd.title", "pd.category", "pd.client_name", "pd.project_date", "ps.name").
 		Joins("inner join portofolio_sections as ps on ps.id = pd.portofolio_section_id").
@@ -52,8 +52,8 @@ func (h *portofolioDetailRepository) FetchAllPortofolioDetail(ctx context.Contex
 		Rows()
 
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllPortofolioDetail - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllPortofolioDetail - 1")
+		return nil, dbError(err, "portofolio detail")
 	}
 
 	var portofolioDetailRepositoryEntities []entity.PortofolioDetailEntity
@@ -67,8 +67,8 @@ func (h *portofolioDetailRepository) FetchAllPortofolioDetail(ctx context.Contex
 			&portofolioDetail.PortofolioSection.Name)
 
 		if err != nil {
-			log.Errorf("[REPOSITORY] FetchAllPortofolioDetail - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllPortofolioDetail - 2")
+			return nil, dbError(err, "portofolio detail")
 		}
 
 		portofolioDetailRepositoryEntities = append(portofolioDetailRepositoryEntities, portofolioDetail)
@@ -79,7 +79,7 @@ func (h *portofolioDetailRepository) FetchAllPortofolioDetail(ctx context.Contex
 
 // FetchByIDPortofolioDetail implements PortofolioDetailInterface.
 func (h *portofolioDetailRepository) FetchByIDPortofolioDetail(ctx context.Context, id int64) (*entity.PortofolioDetailEntity, error) {
-	rows, err := h.DB.
+	rows, err := dbConn(ctx, h.DB).
 		Table("portofolio_details as pd").
 		Select("pd.id", "pd.title", "pd.category", "pd.client_name", "pd.project_date", "pd.description", "pd.project_url", "ps.id", "ps.name", "ps.thumbnail").
 		Joins("inner join portofolio_sections as ps on ps.id = pd.portofolio_section_id").
@@ -87,8 +87,8 @@ func (h *portofolioDetailRepository) FetchByIDPortofolioDetail(ctx context.Conte
 		Order("pd.created_at DESC").
 		Rows()
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDPortofolioDetail - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDPortofolioDetail - 1")
+		return nil, dbError(err, "portofolio detail")
 	}
 
 	var portofolioDetailEntity entity.PortofolioDetailEntity
@@ -105,8 +105,8 @@ func (h *portofolioDetailRepository) FetchByIDPortofolioDetail(ctx context.Conte
 			&portofolioDetailEntity.PortofolioSection.Thumbnail)
 
 		if err != nil {
-			log.Errorf("[REPOSITORY] FetchByIDPortofolioDetail - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDPortofolioDetail - 2")
+			return nil, dbError(err, "portofolio detail")
 		}
 	}
 
@@ -117,9 +117,9 @@ func (h *portofolioDetailRepository) FetchByIDPortofolioDetail(ctx context.Conte
 func (h *portofolioDetailRepository) EditByIDPortofolioDetail(ctx context.Context, req entity.PortofolioDetailEntity) error {
 	modelPortofolioDetail := model.PortofolioDetail{}
 
-	if err = h.DB.Where("id =?", req.ID).First(&modelPortofolioDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDPortofolioDetail - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelPortofolioDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDPortofolioDetail - 1")
+		return dbError(err, "portofolio detail")
 	}
 	modelPortofolioDetail.Title = req.Title
 	modelPortofolioDetail.Description = req.Description
@@ -129,9 +129,9 @@ func (h *portofolioDetailRepository) EditByIDPortofolioDetail(ctx context.Contex
 	modelPortofolioDetail.ProjectUrl = &req.ProjectUrl
 	modelPortofolioDetail.PortofolioSectionID = req.PortofolioSection.ID
 
-	if err = h.DB.Save(&modelPortofolioDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDPortofolioDetail - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Save(&modelPortofolioDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDPortofolioDetail - 2")
+		return dbError(err, "portofolio detail")
 	}
 	return nil
 }
@@ -140,21 +140,21 @@ func (h *portofolioDetailRepository) EditByIDPortofolioDetail(ctx context.Contex
 func (h *portofolioDetailRepository) DeleteByIDPortofolioDetail(ctx context.Context, id int64) error {
 	modelPortofolioDetail := model.PortofolioDetail{}
 
-	if err = h.DB.Where("id = ?", id).First(&modelPortofolioDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDPortofolioDetail - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelPortofolioDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDPortofolioDetail - 1")
+		return dbError(err, "portofolio detail")
 	}
 
-	if err = h.DB.Delete(&modelPortofolioDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDPortofolioDetail - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Delete(&modelPortofolioDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDPortofolioDetail - 2")
+		return dbError(err, "portofolio detail")
 	}
 	return nil
 }
 
 // FetchDetailPotofolioByPortoID implements PortofolioDetailRepositoryInterface.
 func (h *portofolioDetailRepository) FetchDetailPotofolioByPortoID(ctx context.Context, portoID int64) (*entity.PortofolioDetailEntity, error) {
-	rows, err := h.DB.
+	rows, err := dbConn(ctx, h.DB).
 		Table("portofolio_details as pd").
 		Select("pd.id", "pd.title", "pd.category", "pd.client_name",
 			"pd.project_date", "pd.description", "pd.project_url", "ps.id", "ps.name", "ps.thumbnail").
@@ -163,8 +163,8 @@ func (h *portofolioDetailRepository) FetchDetailPotofolioByPortoID(ctx context.C
 		Order("ps.created_at DESC").
 		Rows()
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchDetailPotofolioByPortoID - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchDetailPotofolioByPortoID - 1")
+		return nil, dbError(err, "portofolio detail")
 	}
 
 	var portofolioDetailEntity entity.PortofolioDetailEntity
@@ -181,11 +181,28 @@ func (h *portofolioDetailRepository) FetchDetailPotofolioByPortoID(ctx context.C
 			&portofolioDetailEntity.PortofolioSection.Thumbnail)
 
 		if err != nil {
-			log.Errorf("[REPOSITORY] FetchDetailPotofolioByPortoID - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchDetailPotofolioByPortoID - 2")
+			return nil, dbError(err, "portofolio detail")
 		}
 	}
 
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypePortofolioDetail, portofolioDetailEntity.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchDetailPotofolioByPortoID - 3")
+		return nil, dbError(err, "portofolio detail")
+	}
+
+	sectionTranslations, err := contentTranslations(ctx, h.DB, entity.ContentTypePortofolioSection, portofolioDetailEntity.PortofolioSection.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchDetailPotofolioByPortoID - 4")
+		return nil, dbError(err, "portofolio detail")
+	}
+
+	portofolioDetailEntity.Category = translations.value(portofolioDetailEntity.ID, "category", portofolioDetailEntity.Category)
+	portofolioDetailEntity.Title = translations.value(portofolioDetailEntity.ID, "title", portofolioDetailEntity.Title)
+	portofolioDetailEntity.Description = translations.value(portofolioDetailEntity.ID, "description", portofolioDetailEntity.Description)
+	portofolioDetailEntity.PortofolioSection.Name = sectionTranslations.value(portofolioDetailEntity.PortofolioSection.ID, "name", portofolioDetailEntity.PortofolioSection.Name)
+
 	return &portofolioDetailEntity, nil
 }
 func NewPortofolioDetailRepository(DB *gorm.DB) PortofolioDetailRepositoryInterface {
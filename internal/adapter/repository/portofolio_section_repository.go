@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -28,9 +28,9 @@ func (h *portofolioSectionRepository) CreatePortofolioSection(ctx context.Contex
 		Tagline:   req.Tagline,
 	}
 
-	if err = h.DB.Create(&modelPortofolioSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreatePortofolioSection - 1: %v", err)
-		return err
+	if err := createLast(dbConn(ctx, h.DB), &modelPortofolioSection, &modelPortofolioSection.Position); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreatePortofolioSection - 1")
+		return dbError(err, "portofolio section")
 	}
 	return nil
 }
@@ -38,18 +38,30 @@ func (h *portofolioSectionRepository) CreatePortofolioSection(ctx context.Contex
 // FetchAllPortofolioSection implements PortofolioSectionInterface.
 func (h *portofolioSectionRepository) FetchAllPortofolioSection(ctx context.Context) ([]entity.PortofolioSectionEntity, error) {
 	modelPortofolioSection := []model.PortofolioSection{}
-	if err = h.DB.Select("id", "thumbnail", "tagline", "name").Find(&modelPortofolioSection).Order("created_at DESC").Error; err != nil {
-		log.Errorf("[REPOSITORY] FetchAllPortofolioSection - 1: %v", err)
-		return nil, err
+	if err := dbConn(ctx, h.DB).Select("id", "thumbnail", "tagline", "name", "position").Order("position ASC, id ASC").Find(&modelPortofolioSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllPortofolioSection - 1")
+		return nil, dbError(err, "portofolio section")
+	}
+
+	var ids []int64
+	for _, v := range modelPortofolioSection {
+		ids = append(ids, v.ID)
+	}
+
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypePortofolioSection, ids...)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllPortofolioSection - 2")
+		return nil, dbError(err, "portofolio section")
 	}
 
 	var portofolioSectionRepositoryEntities []entity.PortofolioSectionEntity
 	for _, v := range modelPortofolioSection {
 		portofolioSectionRepositoryEntities = append(portofolioSectionRepositoryEntities, entity.PortofolioSectionEntity{
 			ID:        v.ID,
+			Position:  v.Position,
 			Thumbnail: *v.Thumbnail,
-			Name:      v.Name,
-			Tagline:   v.Tagline,
+			Name:      translations.value(v.ID, "name", v.Name),
+			Tagline:   translations.value(v.ID, "tagline", v.Tagline),
 		})
 	}
 
@@ -59,13 +71,14 @@ func (h *portofolioSectionRepository) FetchAllPortofolioSection(ctx context.Cont
 // FetchByIDPortofolioSection implements PortofolioSectionInterface.
 func (h *portofolioSectionRepository) FetchByIDPortofolioSection(ctx context.Context, id int64) (*entity.PortofolioSectionEntity, error) {
 	modelPortofolioSection := model.PortofolioSection{}
-	if err = h.DB.Select("id", "thumbnail", "tagline", "name").Where("id = ?", id).First(&modelPortofolioSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDPortofolioSection - 1: %v", err)
-		return nil, err
+	if err := dbConn(ctx, h.DB).Select("id", "thumbnail", "tagline", "name", "position").Where("id = ?", id).First(&modelPortofolioSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDPortofolioSection - 1")
+		return nil, dbError(err, "portofolio section")
 	}
 
 	return &entity.PortofolioSectionEntity{
 		ID:        modelPortofolioSection.ID,
+		Position:  modelPortofolioSection.Position,
 		Thumbnail: *modelPortofolioSection.Thumbnail,
 		Name:      modelPortofolioSection.Name,
 		Tagline:   modelPortofolioSection.Tagline,
@@ -76,17 +89,17 @@ func (h *portofolioSectionRepository) FetchByIDPortofolioSection(ctx context.Con
 func (h *portofolioSectionRepository) EditByIDPortofolioSection(ctx context.Context, req entity.PortofolioSectionEntity) error {
 	modelPortofolioSection := model.PortofolioSection{}
 
-	if err = h.DB.Where("id =?", req.ID).First(&modelPortofolioSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDPortofolioSection - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelPortofolioSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDPortofolioSection - 1")
+		return dbError(err, "portofolio section")
 	}
 	modelPortofolioSection.Name = req.Name
 	modelPortofolioSection.Tagline = req.Tagline
 	modelPortofolioSection.Thumbnail = &req.Thumbnail
 
-	if err = h.DB.Save(&modelPortofolioSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDPortofolioSection - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Save(&modelPortofolioSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDPortofolioSection - 2")
+		return dbError(err, "portofolio section")
 	}
 	return nil
 }
@@ -95,14 +108,14 @@ func (h *portofolioSectionRepository) EditByIDPortofolioSection(ctx context.Cont
 func (h *portofolioSectionRepository) DeleteByIDPortofolioSection(ctx context.Context, id int64) error {
 	modelPortofolioSection := model.PortofolioSection{}
 
-	if err = h.DB.Where("id = ?", id).First(&modelPortofolioSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDPortofolioSection - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelPortofolioSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDPortofolioSection - 1")
+		return dbError(err, "portofolio section")
 	}
 
-	if err = h.DB.Delete(&modelPortofolioSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDPortofolioSection - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Delete(&modelPortofolioSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDPortofolioSection - 2")
+		return dbError(err, "portofolio section")
 	}
 	return nil
 }
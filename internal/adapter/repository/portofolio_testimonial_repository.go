@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -30,16 +30,16 @@ func (h *portofolioTestimonialRepository) CreatePortofolioTestimonial(ctx contex
 		Role:                req.Role,
 	}
 
-	if err = h.DB.Create(&modelPortofolioTestimonial).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreatePortofolioTestimonial - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Create(&modelPortofolioTestimonial).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreatePortofolioTestimonial - 1")
+		return dbError(err, "portofolio testimonial")
 	}
 	return nil
 }
 
 // FetchAllPortofolioTestimonial implements PortofolioTestimonialInterface.
 func (h *portofolioTestimonialRepository) FetchAllPortofolioTestimonial(ctx context.Context) ([]entity.PortofolioTestimonialEntity, error) {
-	rows, err := h.DB.
+	rows, err := dbConn(ctx, h.DB).
 		Table("portofolio_testimonials as pd").
 		Select("pd.id", "pd.thumbnail", "pd.message", "pd.client_name", "pd.role", "ps.name").
 		Joins("inner join portofolio_sections as ps on ps.id = pd.portofolio_section_id").
@@ -48,8 +48,8 @@ func (h *portofolioTestimonialRepository) FetchAllPortofolioTestimonial(ctx cont
 		Rows()
 
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchAllPortofolioTestimonial - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllPortofolioTestimonial - 1")
+		return nil, dbError(err, "portofolio testimonial")
 	}
 
 	var portofolioTestimonialRepositoryEntities []entity.PortofolioTestimonialEntity
@@ -63,19 +63,35 @@ func (h *portofolioTestimonialRepository) FetchAllPortofolioTestimonial(ctx cont
 			&portofolioTestimonial.PortofolioSection.Name)
 
 		if err != nil {
-			log.Errorf("[REPOSITORY] FetchAllPortofolioTestimonial - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllPortofolioTestimonial - 2")
+			return nil, dbError(err, "portofolio testimonial")
 		}
 
 		portofolioTestimonialRepositoryEntities = append(portofolioTestimonialRepositoryEntities, portofolioTestimonial)
 	}
 
+	var ids []int64
+	for _, v := range portofolioTestimonialRepositoryEntities {
+		ids = append(ids, v.ID)
+	}
+
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypePortofolioTestimonial, ids...)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllPortofolioTestimonial - 3")
+		return nil, dbError(err, "portofolio testimonial")
+	}
+
+	for i, v := range portofolioTestimonialRepositoryEntities {
+		portofolioTestimonialRepositoryEntities[i].Message = translations.value(v.ID, "message", v.Message)
+		portofolioTestimonialRepositoryEntities[i].Role = translations.value(v.ID, "role", v.Role)
+	}
+
 	return portofolioTestimonialRepositoryEntities, nil
 }
 
 // FetchByIDPortofolioTestimonial implements PortofolioTestimonialInterface.
 func (h *portofolioTestimonialRepository) FetchByIDPortofolioTestimonial(ctx context.Context, id int64) (*entity.PortofolioTestimonialEntity, error) {
-	rows, err := h.DB.
+	rows, err := dbConn(ctx, h.DB).
 		Table("portofolio_testimonials as pd").
 		Select("pd.id", "pd.thumbnail", "pd.message", "pd.client_name", "pd.role", "ps.id", "ps.name", "ps.thumbnail").
 		Joins("inner join portofolio_sections as ps on ps.id = pd.portofolio_section_id").
@@ -83,8 +99,8 @@ func (h *portofolioTestimonialRepository) FetchByIDPortofolioTestimonial(ctx con
 		Order("created_at DESC").
 		Rows()
 	if err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDPortofolioTestimonial - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDPortofolioTestimonial - 1")
+		return nil, dbError(err, "portofolio testimonial")
 	}
 
 	var portofolioTestimonialEntity entity.PortofolioTestimonialEntity
@@ -99,8 +115,8 @@ func (h *portofolioTestimonialRepository) FetchByIDPortofolioTestimonial(ctx con
 			&portofolioTestimonialEntity.PortofolioSection.Thumbnail)
 
 		if err != nil {
-			log.Errorf("[REPOSITORY] FetchByIDPortofolioTestimonial - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDPortofolioTestimonial - 2")
+			return nil, dbError(err, "portofolio testimonial")
 		}
 	}
 
@@ -111,9 +127,9 @@ func (h *portofolioTestimonialRepository) FetchByIDPortofolioTestimonial(ctx con
 func (h *portofolioTestimonialRepository) EditByIDPortofolioTestimonial(ctx context.Context, req entity.PortofolioTestimonialEntity) error {
 	modelPortofolioTestimonial := model.PortofolioTestimonial{}
 
-	if err = h.DB.Where("id =?", req.ID).First(&modelPortofolioTestimonial).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDPortofolioTestimonial - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelPortofolioTestimonial).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDPortofolioTestimonial - 1")
+		return dbError(err, "portofolio testimonial")
 	}
 	modelPortofolioTestimonial.Thumbnail = req.Thumbnail
 	modelPortofolioTestimonial.Message = req.Message
@@ -121,9 +137,9 @@ func (h *portofolioTestimonialRepository) EditByIDPortofolioTestimonial(ctx cont
 	modelPortofolioTestimonial.Role = req.Role
 	modelPortofolioTestimonial.PortofolioSectionID = req.PortofolioSection.ID
 
-	if err = h.DB.Save(&modelPortofolioTestimonial).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDPortofolioTestimonial - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Save(&modelPortofolioTestimonial).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDPortofolioTestimonial - 2")
+		return dbError(err, "portofolio testimonial")
 	}
 	return nil
 }
@@ -132,14 +148,14 @@ func (h *portofolioTestimonialRepository) EditByIDPortofolioTestimonial(ctx cont
 func (h *portofolioTestimonialRepository) DeleteByIDPortofolioTestimonial(ctx context.Context, id int64) error {
 	modelPortofolioTestimonial := model.PortofolioTestimonial{}
 
-	if err = h.DB.Where("id = ?", id).First(&modelPortofolioTestimonial).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDPortofolioTestimonial - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id = ?", id).First(&modelPortofolioTestimonial).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDPortofolioTestimonial - 1")
+		return dbError(err, "portofolio testimonial")
 	}
 
-	if err = h.DB.Delete(&modelPortofolioTestimonial).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDPortofolioTestimonial - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Delete(&modelPortofolioTestimonial).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDPortofolioTestimonial - 2")
+		return dbError(err, "portofolio testimonial")
 	}
 	return nil
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
+	"time"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ServiceDetailRepositoryInterface interface {
@@ -17,6 +19,9 @@ type ServiceDetailRepositoryInterface interface {
 	DeleteByIDServiceDetail(ctx context.Context, id int64) error
 
 	GetByServiceIDDetail(ctx context.Context, id int64) (*entity.ServiceDetailEntity, error)
+
+	IncrementDownloadServiceDetail(ctx context.Context, id int64, fileType string) error
+	FetchDownloadServiceDetail(ctx context.Context, id int64) ([]entity.ServiceDetailDownloadEntity, error)
 }
 type serviceDetailRepository struct {
 	DB *gorm.DB
@@ -33,9 +38,9 @@ func (h *serviceDetailRepository) CreateServiceDetail(ctx context.Context, req e
 		PathDocx:    req.PathDocx,
 	}
 
-	if err := h.DB.Create(&modelServiceDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateServiceDetail - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Create(&modelServiceDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateServiceDetail - 1")
+		return dbError(err, "service detail")
 	}
 	return nil
 }
@@ -44,9 +49,9 @@ func (h *serviceDetailRepository) CreateServiceDetail(ctx context.Context, req e
 func (h *serviceDetailRepository) FetchAllServiceDetail(ctx context.Context) ([]entity.ServiceDetailEntity, error) {
 	modelServiceDetail := []model.ServiceDetail{}
 
-	if err := h.DB.Select("id", "service_id", "path_image", "title", "description", "path_pdf", "path_docx").Find(&modelServiceDetail).Order("created_at DESC").Error; err != nil {
-		log.Errorf("[REPOSITORY] FetchAllServiceDetail - 1: %v", err)
-		return nil, err
+	if err := dbConn(ctx, h.DB).Select("id", "service_id", "path_image", "title", "description", "path_pdf", "path_docx").Find(&modelServiceDetail).Order("created_at DESC").Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllServiceDetail - 1")
+		return nil, dbError(err, "service detail")
 	}
 
 	var serviceDetailRepositoryEntities []entity.ServiceDetailEntity
@@ -69,9 +74,9 @@ func (h *serviceDetailRepository) FetchAllServiceDetail(ctx context.Context) ([]
 func (h *serviceDetailRepository) FetchByIDServiceDetail(ctx context.Context, id int64) (*entity.ServiceDetailEntity, error) {
 	modelServiceDetail := model.ServiceDetail{}
 
-	if err := h.DB.Select("id", "service_id", "path_image", "title", "description", "path_pdf", "path_docx").Where("id = ?", id).First(&modelServiceDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDServiceDetail - 1: %v", err)
-		return nil, err
+	if err := dbConn(ctx, h.DB).Select("id", "service_id", "path_image", "title", "description", "path_pdf", "path_docx").Where("id = ?", id).First(&modelServiceDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDServiceDetail - 1")
+		return nil, dbError(err, "service detail")
 	}
 
 	return &entity.ServiceDetailEntity{
@@ -89,9 +94,9 @@ func (h *serviceDetailRepository) FetchByIDServiceDetail(ctx context.Context, id
 func (h *serviceDetailRepository) EditByIDServiceDetail(ctx context.Context, req entity.ServiceDetailEntity) error {
 	modelServiceDetail := model.ServiceDetail{}
 
-	if err := h.DB.Where("id =?", req.ID).First(&modelServiceDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDServiceDetail - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelServiceDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDServiceDetail - 1")
+		return dbError(err, "service detail")
 	}
 	modelServiceDetail.Description = req.Description
 	modelServiceDetail.Title = req.Title
@@ -100,9 +105,9 @@ func (h *serviceDetailRepository) EditByIDServiceDetail(ctx context.Context, req
 	modelServiceDetail.PathDocx = req.PathDocx
 	modelServiceDetail.ServiceID = req.ServiceID
 
-	if err := h.DB.Save(&modelServiceDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDServiceDetail - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Save(&modelServiceDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDServiceDetail - 2")
+		return dbError(err, "service detail")
 	}
 	return nil
 }
@@ -111,14 +116,14 @@ func (h *serviceDetailRepository) EditByIDServiceDetail(ctx context.Context, req
 func (h *serviceDetailRepository) DeleteByIDServiceDetail(ctx context.Context, id int64) error {
 	modelServiceDetail := model.ServiceDetail{}
 
-	if err := h.DB.Where("id =?", id).First(&modelServiceDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDServiceDetail - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id =?", id).First(&modelServiceDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDServiceDetail - 1")
+		return dbError(err, "service detail")
 	}
 
-	if err := h.DB.Delete(&modelServiceDetail).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDServiceDetail - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Delete(&modelServiceDetail).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDServiceDetail - 2")
+		return dbError(err, "service detail")
 	}
 
 	return nil
@@ -126,25 +131,82 @@ func (h *serviceDetailRepository) DeleteByIDServiceDetail(ctx context.Context, i
 
 // GetByServiceIDDetail implements ServiceDetailRepositoryInterface.
 func (h *serviceDetailRepository) GetByServiceIDDetail(ctx context.Context, id int64) (*entity.ServiceDetailEntity, error) {
-	rows, err := h.DB.Table("Service details as ack").
+	rows, err := dbConn(ctx, h.DB).Table("Service details as ack").
 		Select("ack.id", "ack.path_image", "ack.description", "ack.path_pdf", "ack.path_docx", "ac.name").
 		Joins("inner join service_sections as ac on ac.id = ack.service_id").
 		Where("ack.deleted_at IS NULL").
 		Rows()
 	if err != nil {
-		log.Errorf("[REPOSITORY] GetByServiceIDDetail - 1: %v", err)
-		return nil, err
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] GetByServiceIDDetail - 1")
+		return nil, dbError(err, "service detail")
 	}
 	serviceDetail := entity.ServiceDetailEntity{}
 	for rows.Next() {
 		err = rows.Scan(&serviceDetail.ID, &serviceDetail.PathImage, &serviceDetail.Description, &serviceDetail.PathPdf, &serviceDetail.PathDocx, &serviceDetail.ServiceName)
 		if err != nil {
-			log.Errorf("[REPOSITORY] GetByServiceIDDetail - 2: %v", err)
-			return nil, err
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] GetByServiceIDDetail - 2")
+			return nil, dbError(err, "service detail")
 		}
 	}
+
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypeServiceDetail, serviceDetail.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] GetByServiceIDDetail - 3")
+		return nil, dbError(err, "service detail")
+	}
+
+	serviceDetail.Title = translations.value(serviceDetail.ID, "title", serviceDetail.Title)
+	serviceDetail.Description = translations.value(serviceDetail.ID, "description", serviceDetail.Description)
 	return &serviceDetail, nil
 }
+
+// IncrementDownloadServiceDetail implements ServiceDetailRepositoryInterface.
+func (h *serviceDetailRepository) IncrementDownloadServiceDetail(ctx context.Context, id int64, fileType string) error {
+	now := time.Now()
+	modelDownload := model.ServiceDetailDownload{
+		ServiceDetailID:  id,
+		FileType:         fileType,
+		Total:            1,
+		LastDownloadedAt: &now,
+	}
+
+	err := dbConn(ctx, h.DB).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "service_detail_id"}, {Name: "file_type"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"total":              gorm.Expr("service_detail_downloads.total + 1"),
+			"last_downloaded_at": now,
+		}),
+	}).Create(&modelDownload).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] IncrementDownloadServiceDetail - 1")
+		return dbError(err, "service detail")
+	}
+	return nil
+}
+
+// FetchDownloadServiceDetail implements ServiceDetailRepositoryInterface.
+func (h *serviceDetailRepository) FetchDownloadServiceDetail(ctx context.Context, id int64) ([]entity.ServiceDetailDownloadEntity, error) {
+	modelDownloads := []model.ServiceDetailDownload{}
+	err := dbConn(ctx, h.DB).Where("service_detail_id = ?", id).
+		Order("file_type ASC").
+		Find(&modelDownloads).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchDownloadServiceDetail - 1")
+		return nil, dbError(err, "service detail")
+	}
+
+	var downloadEntities []entity.ServiceDetailDownloadEntity
+	for _, v := range modelDownloads {
+		downloadEntities = append(downloadEntities, entity.ServiceDetailDownloadEntity{
+			FileType:         v.FileType,
+			Total:            v.Total,
+			LastDownloadedAt: v.LastDownloadedAt,
+		})
+	}
+
+	return downloadEntities, nil
+}
+
 func NewServiceDetailRepository(DB *gorm.DB) ServiceDetailRepositoryInterface {
 	return &serviceDetailRepository{
 		DB: DB,
@@ -5,7 +5,7 @@ import (
 	"latihan-compro/internal/core/domain/entity"
 	"latihan-compro/internal/core/domain/model"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -27,10 +27,9 @@ func (h *serviceSectionRepository) CreateServiceSection(ctx context.Context, req
 		Name:     req.Name,
 		Tagline:  req.Tagline,
 	}
-	if err := h.DB.Create(&modelServiceSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] CreateServiceSection - 1: %v", err)
-		return err
-
+	if err := createLast(dbConn(ctx, h.DB), &modelServiceSection, &modelServiceSection.Position); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] CreateServiceSection - 1")
+		return dbError(err, "service section")
 	}
 	return nil
 }
@@ -38,18 +37,30 @@ func (h *serviceSectionRepository) CreateServiceSection(ctx context.Context, req
 // FetchAllServiceSection implements ServiceSectionInterface.
 func (h *serviceSectionRepository) FetchAllServiceSection(ctx context.Context) ([]entity.ServiceSectionEntity, error) {
 	modelServiceSection := []model.ServiceSection{}
-	if err = h.DB.Select("id", "path_icon", "tagline", "name").Find(&modelServiceSection).Order("created_at DESC").Error; err != nil {
-		log.Errorf("[REPOSITORY] FetchAllServiceSection - 1: %v", err)
-		return nil, err
+	if err := dbConn(ctx, h.DB).Select("id", "path_icon", "tagline", "name", "position").Order("position ASC, id ASC").Find(&modelServiceSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllServiceSection - 1")
+		return nil, dbError(err, "service section")
+	}
+
+	var ids []int64
+	for _, v := range modelServiceSection {
+		ids = append(ids, v.ID)
+	}
+
+	translations, err := contentTranslations(ctx, h.DB, entity.ContentTypeServiceSection, ids...)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllServiceSection - 2")
+		return nil, dbError(err, "service section")
 	}
 
 	var serviceSectionRepositoryEntities []entity.ServiceSectionEntity
 	for _, v := range modelServiceSection {
 		serviceSectionRepositoryEntities = append(serviceSectionRepositoryEntities, entity.ServiceSectionEntity{
 			ID:       v.ID,
+			Position: v.Position,
 			PathIcon: v.PathIcon,
-			Name:     v.Name,
-			Tagline:  v.Tagline,
+			Name:     translations.value(v.ID, "name", v.Name),
+			Tagline:  translations.value(v.ID, "tagline", v.Tagline),
 		})
 	}
 
@@ -59,13 +70,14 @@ func (h *serviceSectionRepository) FetchAllServiceSection(ctx context.Context) (
 // FetchByIDServiceSection implements ServiceSectionInterface.
 func (h *serviceSectionRepository) FetchByIDServiceSection(ctx context.Context, id int64) (*entity.ServiceSectionEntity, error) {
 	modelServiceSection := model.ServiceSection{}
-	if err = h.DB.Select("id", "path_icon", "tagline", "name").Where("id = ?", id).First(&modelServiceSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] FetchByIDServiceSection - 1: %v", err)
-		return nil, err
+	if err := dbConn(ctx, h.DB).Select("id", "path_icon", "tagline", "name", "position").Where("id = ?", id).First(&modelServiceSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchByIDServiceSection - 1")
+		return nil, dbError(err, "service section")
 	}
 
 	return &entity.ServiceSectionEntity{
 		ID:       modelServiceSection.ID,
+		Position: modelServiceSection.Position,
 		PathIcon: modelServiceSection.PathIcon,
 		Name:     modelServiceSection.Name,
 		Tagline:  modelServiceSection.Tagline,
@@ -75,18 +87,18 @@ func (h *serviceSectionRepository) FetchByIDServiceSection(ctx context.Context,
 // EditByIDServiceSection implements ServiceSectionInterface.
 func (h *serviceSectionRepository) EditByIDServiceSection(ctx context.Context, req entity.ServiceSectionEntity) error {
 	modelServiceSection := model.ServiceSection{}
-	if err := h.DB.Where("id =?", req.ID).First(&modelServiceSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDServiceSection - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id =?", req.ID).First(&modelServiceSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDServiceSection - 1")
+		return dbError(err, "service section")
 	}
 
 	modelServiceSection.PathIcon = req.PathIcon
 	modelServiceSection.Name = req.Name
 	modelServiceSection.Tagline = req.Tagline
 
-	if err := h.DB.Save(&modelServiceSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] EditByIDServiceSection - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Save(&modelServiceSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] EditByIDServiceSection - 2")
+		return dbError(err, "service section")
 	}
 
 	return nil
@@ -96,14 +108,14 @@ func (h *serviceSectionRepository) EditByIDServiceSection(ctx context.Context, r
 
 func (h *serviceSectionRepository) DeleteByIDServiceSection(ctx context.Context, id int64) error {
 	modelServiceSection := model.ServiceSection{}
-	if err := h.DB.Where("id =?", id).First(&modelServiceSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDServiceSection - 1: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Where("id =?", id).First(&modelServiceSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDServiceSection - 1")
+		return dbError(err, "service section")
 	}
 
-	if err := h.DB.Delete(&modelServiceSection).Error; err != nil {
-		log.Errorf("[REPOSITORY] DeleteByIDServiceSection - 2: %v", err)
-		return err
+	if err := dbConn(ctx, h.DB).Delete(&modelServiceSection).Error; err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] DeleteByIDServiceSection - 2")
+		return dbError(err, "service section")
 	}
 
 	return nil
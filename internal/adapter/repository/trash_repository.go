@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/model"
+	"latihan-compro/utils/conv"
+	"reflect"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TrashRepositoryInterface interface {
+	FetchAllTrash(ctx context.Context, contentType string) ([]entity.TrashEntity, error)
+	RestoreTrash(ctx context.Context, contentType string, id int64) error
+	PurgeTrash(ctx context.Context, contentType string, id int64) error
+	PurgeTrashBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type trashRepository struct {
+	DB *gorm.DB
+}
+
+// FetchAllTrash implements TrashRepositoryInterface.
+func (h *trashRepository) FetchAllTrash(ctx context.Context, contentType string) ([]entity.TrashEntity, error) {
+	content, err := newContentModel(contentType)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllTrash - 1")
+		return nil, dbError(err, "content")
+	}
+
+	rows := reflect.New(reflect.SliceOf(reflect.TypeOf(content)))
+	err = dbConn(ctx, h.DB).Unscoped().
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Find(rows.Interface()).Error
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllTrash - 2")
+		return nil, dbError(err, "content")
+	}
+
+	var trashEntities []entity.TrashEntity
+	for i := 0; i < rows.Elem().Len(); i++ {
+		row := rows.Elem().Index(i)
+		snapshot, err := json.Marshal(row.Interface())
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] FetchAllTrash - 3")
+			return nil, err
+		}
+
+		trashEntities = append(trashEntities, entity.TrashEntity{
+			ContentType: contentType,
+			ID:          row.Elem().FieldByName("ID").Int(),
+			Snapshot:    string(snapshot),
+			DeletedAt:   row.Elem().FieldByName("DeletedAt").Interface().(gorm.DeletedAt).Time,
+		})
+	}
+
+	return trashEntities, nil
+}
+
+// RestoreTrash implements TrashRepositoryInterface.
+func (h *trashRepository) RestoreTrash(ctx context.Context, contentType string, id int64) error {
+	content, err := newContentModel(contentType)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] RestoreTrash - 1")
+		return dbError(err, "content")
+	}
+
+	result := dbConn(ctx, h.DB).Unscoped().Model(content).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		log.Ctx(ctx).Error().Err(result.Error).Msg("[REPOSITORY] RestoreTrash - 2")
+		return dbError(result.Error, "content")
+	}
+
+	if result.RowsAffected == 0 {
+		log.Ctx(ctx).Error().Err(gorm.ErrRecordNotFound).Msg("[REPOSITORY] RestoreTrash - 3")
+		return dbError(gorm.ErrRecordNotFound, "content")
+	}
+	return nil
+}
+
+// PurgeTrash implements TrashRepositoryInterface.
+func (h *trashRepository) PurgeTrash(ctx context.Context, contentType string, id int64) error {
+	err := dbConn(ctx, h.DB).Transaction(func(tx *gorm.DB) error {
+		return purgeContent(tx, contentType, id)
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] PurgeTrash - 1")
+		return dbError(err, "content")
+	}
+	return nil
+}
+
+// PurgeTrashBefore implements TrashRepositoryInterface.
+// Every content row soft-deleted before the given time is removed permanently,
+// rows still referenced by live content are kept until it is deleted too.
+func (h *trashRepository) PurgeTrashBefore(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	for _, contentType := range entity.ContentTypes {
+		content, err := newContentModel(contentType)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] PurgeTrashBefore - 1")
+			return purged, dbError(err, "content")
+		}
+
+		var ids []int64
+		err = dbConn(ctx, h.DB).Unscoped().Model(content).Where("deleted_at < ?", before).Pluck("id", &ids).Error
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] PurgeTrashBefore - 2")
+			return purged, dbError(err, "content")
+		}
+
+		for _, id := range ids {
+			err = dbConn(ctx, h.DB).Transaction(func(tx *gorm.DB) error {
+				return purgeContent(tx, contentType, id)
+			})
+			switch {
+			case err == nil:
+				purged++
+			case errors.Is(err, conv.ErrContentHasChildren):
+				log.Ctx(ctx).Warn().Err(err).Str("content_type", contentType).Int64("content_id", id).Msg("[REPOSITORY] PurgeTrashBefore - 3")
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				// Purged with its parent earlier in the loop.
+			default:
+				log.Ctx(ctx).Error().Err(err).Msg("[REPOSITORY] PurgeTrashBefore - 4")
+				return purged, dbError(err, "content")
+			}
+		}
+	}
+
+	return purged, nil
+}
+
+// trashedRow is the part of a content row purgeContent needs.
+type trashedRow struct {
+	ID        int64
+	DeletedAt gorm.DeletedAt
+}
+
+// purgeContent permanently deletes a soft-deleted row with its translations
+// and revisions. Its children, which the database would cascade delete, must
+// all be soft-deleted too and are purged the same way, otherwise
+// conv.ErrContentHasChildren is returned and nothing is deleted.
+func purgeContent(tx *gorm.DB, contentType string, id int64) error {
+	content, err := newContentModel(contentType)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(content).Error
+	if err != nil {
+		return err
+	}
+
+	for _, child := range contentChildren[contentType] {
+		childContent, err := newContentModel(child.contentType)
+		if err != nil {
+			return err
+		}
+
+		rows := []trashedRow{}
+		err = tx.Unscoped().Model(childContent).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "deleted_at").
+			Where(child.column+" = ?", id).
+			Find(&rows).Error
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			if !row.DeletedAt.Valid {
+				return conv.ErrContentHasChildren
+			}
+		}
+		for _, row := range rows {
+			if err = purgeContent(tx, child.contentType, row.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	err = tx.Where("content_type = ? AND content_id = ?", contentType, id).Delete(&model.ContentTranslation{}).Error
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("content_type = ? AND content_id = ?", contentType, id).Delete(&model.ContentRevision{}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Unscoped().Where("id = ?", id).Delete(content).Error
+}
+
+func NewTrashRepository(DB *gorm.DB) TrashRepositoryInterface {
+	return &trashRepository{
+		DB: DB,
+	}
+}
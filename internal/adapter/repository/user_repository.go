@@ -9,11 +9,6 @@ import (
 	"gorm.io/gorm"
 )
 
-var (
-	err  error
-	code string
-)
-
 type UserRepositoryInterface interface {
 	GetUserByEmail(ctx context.Context, email string) (*entity.UserEntity, error)
 }
@@ -26,11 +21,11 @@ type userRepo struct {
 func (u *userRepo) GetUserByEmail(ctx context.Context, email string) (*entity.UserEntity, error) {
 	var modelUser model.User
 
-	err = u.db.Select("email", "password", "name", "id").Where("email = ?", email).First(&modelUser).Error
+	err := u.db.WithContext(ctx).Select("email", "password", "name", "id").Where("email = ?", email).First(&modelUser).Error
 	if err != nil {
-		code = "[REPOSITORY] GetUserByEmail - 1"
-		log.Err(err).Msg(code)
-		return nil, err
+		code := "[REPOSITORY] GetUserByEmail - 1"
+		log.Ctx(ctx).Error().Err(err).Msg(code)
+		return nil, dbError(err, "user")
 	}
 
 	return &entity.UserEntity{
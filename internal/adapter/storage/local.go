@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"latihan-compro/config"
+	"latihan-compro/utils/tracing"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultLocalPath  = "storage"
+	defaultLocalRoute = "/storage"
+)
+
+type localStruct struct {
+	root      string
+	publicUrl string
+}
+
+// UploadFile implements StorageInterface.
+func (l *localStruct) UploadFile(ctx context.Context, path string, file io.Reader, size int64, contentType string) (string, error) {
+	ctx, span := startUpload(ctx, DriverLocal, path, contentType)
+	defer span.End()
+
+	target := l.resolve(path)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error creating directory")
+		tracing.Fail(span, err)
+		return "", err
+	}
+
+	dst, err := os.Create(target)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error creating file")
+		tracing.Fail(span, err)
+		return "", err
+	}
+	defer dst.Close()
+
+	if _, err = io.Copy(dst, file); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error writing file")
+		tracing.Fail(span, err)
+		return "", err
+	}
+
+	return l.publicUrl + "/" + cleanPath(path), nil
+}
+
+// Ping implements StorageInterface.
+func (l *localStruct) Ping(ctx context.Context) error {
+	info, err := os.Stat(l.root)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", l.root)
+	}
+	return nil
+}
+
+// DeleteFile implements StorageInterface.
+func (l *localStruct) DeleteFile(path string) error {
+	if err := os.Remove(l.resolve(path)); err != nil && !os.IsNotExist(err) {
+		log.Error().Err(err).Msg("Error deleting file")
+		return err
+	}
+	return nil
+}
+
+// OpenFile implements StorageInterface.
+func (l *localStruct) OpenFile(path string) (io.ReadCloser, error) {
+	file, err := os.Open(l.resolve(path))
+	if err != nil {
+		log.Error().Err(err).Msg("Error opening file")
+		return nil, err
+	}
+	return file, nil
+}
+
+// resolve maps the object path into the storage root, so paths like
+// ../../etc/passwd cannot escape it.
+func (l *localStruct) resolve(path string) string {
+	return filepath.Join(l.root, filepath.FromSlash(cleanPath(path)))
+}
+
+// LocalRoute returns the route the local driver files are served from and
+// the directory behind it.
+func LocalRoute(cfg *config.Config) (string, string) {
+	route := strings.TrimRight(cfg.Storage.LocalRoute, "/")
+	if route == "" {
+		route = defaultLocalRoute
+	}
+
+	root := cfg.Storage.LocalPath
+	if root == "" {
+		root = defaultLocalPath
+	}
+	return route, root
+}
+
+func NewLocal(cfg *config.Config) (StorageInterface, error) {
+	route, root := LocalRoute(cfg)
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+
+	publicUrl := strings.TrimRight(cfg.Storage.LocalPublicUrl, "/")
+	if publicUrl == "" {
+		publicUrl = route
+	}
+
+	return &localStruct{
+		root:      root,
+		publicUrl: publicUrl,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package storage
+
+import "time"
+
+// FileInfo describes a stored file.
+type FileInfo struct {
+	Size        int64
+	ContentType string
+}
+
+// PresignedUpload tells a client how to upload a file straight to storage.
+// POST uploads send Fields as multipart form fields followed by the file in a
+// "file" field, PUT uploads send the file as the body with Headers.
+type PresignedUpload struct {
+	Method    string
+	Url       string
+	Fields    map[string]string
+	Headers   map[string]string
+	ExpiresAt time.Time
+}
+
+// PresignerInterface is implemented by the drivers clients can upload to
+// directly, bypassing the API.
+type PresignerInterface interface {
+	// PresignUpload allows one upload of at most maxSize bytes to path.
+	PresignUpload(path, contentType string, maxSize int64, expiry time.Duration) (*PresignedUpload, error)
+	StatFile(path string) (*FileInfo, error)
+	PublicUrl(path string) string
+}
@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"latihan-compro/config"
+	"latihan-compro/utils/tracing"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/rs/zerolog/log"
+)
+
+// s3PartSize is the size of the parts of a multipart upload. minio-go buffers
+// a whole part in memory, with an unknown size it would otherwise pick parts
+// of over 500 MiB.
+const s3PartSize = 16 << 20
+
+// s3Client is the part of *minio.Client the driver uses.
+type s3Client interface {
+	PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+	RemoveObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error
+	GetObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (*minio.Object, error)
+	BucketExists(ctx context.Context, bucketName string) (bool, error)
+	PresignedPostPolicy(ctx context.Context, policy *minio.PostPolicy) (*url.URL, map[string]string, error)
+	StatObject(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+}
+
+type s3Struct struct {
+	client    s3Client
+	bucket    string
+	publicUrl string
+}
+
+// UploadFile implements StorageInterface.
+// Files up to s3PartSize are sent in a single request, larger ones or ones of
+// unknown size in parts of s3PartSize.
+func (s *s3Struct) UploadFile(ctx context.Context, path string, file io.Reader, size int64, contentType string) (string, error) {
+	ctx, span := startUpload(ctx, DriverS3, path, contentType)
+	defer span.End()
+
+	key := cleanPath(path)
+	_, err := s.client.PutObject(ctx, s.bucket, key, file, size, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    s3PartSize,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error uploading file")
+		tracing.Fail(span, err)
+		return "", err
+	}
+
+	return s.PublicUrl(key), nil
+}
+
+// Ping implements StorageInterface.
+func (s *s3Struct) Ping(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.bucket)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("bucket %s does not exist", s.bucket)
+	}
+	return nil
+}
+
+// DeleteFile implements StorageInterface.
+func (s *s3Struct) DeleteFile(path string) error {
+	err := s.client.RemoveObject(context.Background(), s.bucket, cleanPath(path), minio.RemoveObjectOptions{})
+	if err != nil {
+		log.Error().Err(err).Msg("Error deleting file")
+		return err
+	}
+	return nil
+}
+
+// OpenFile implements StorageInterface.
+func (s *s3Struct) OpenFile(path string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(context.Background(), s.bucket, cleanPath(path), minio.GetObjectOptions{})
+	if err != nil {
+		log.Error().Err(err).Msg("Error opening file")
+		return nil, err
+	}
+
+	// GetObject is lazy, Stat surfaces a missing object before streaming.
+	if _, err = object.Stat(); err != nil {
+		object.Close()
+		log.Error().Err(err).Msg("Error opening file")
+		return nil, err
+	}
+	return object, nil
+}
+
+// PresignUpload implements PresignerInterface.
+// The POST policy makes S3 itself enforce the key, type and size.
+func (s *s3Struct) PresignUpload(path, contentType string, maxSize int64, expiry time.Duration) (*PresignedUpload, error) {
+	expiresAt := time.Now().Add(expiry)
+
+	policy := minio.NewPostPolicy()
+	for _, err := range []error{
+		policy.SetBucket(s.bucket),
+		policy.SetKey(cleanPath(path)),
+		policy.SetExpires(expiresAt),
+		policy.SetContentType(contentType),
+		policy.SetContentLengthRange(1, maxSize),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	url, fields, err := s.client.PresignedPostPolicy(context.Background(), policy)
+	if err != nil {
+		log.Error().Err(err).Msg("Error presigning upload")
+		return nil, err
+	}
+
+	return &PresignedUpload{
+		Method:    http.MethodPost,
+		Url:       url.String(),
+		Fields:    fields,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// StatFile implements PresignerInterface.
+func (s *s3Struct) StatFile(path string) (*FileInfo, error) {
+	info, err := s.client.StatObject(context.Background(), s.bucket, cleanPath(path), minio.StatObjectOptions{})
+	if err != nil {
+		log.Error().Err(err).Msg("Error reading file info")
+		return nil, err
+	}
+
+	return &FileInfo{
+		Size:        info.Size,
+		ContentType: info.ContentType,
+	}, nil
+}
+
+// PublicUrl implements PresignerInterface.
+func (s *s3Struct) PublicUrl(path string) string {
+	return s.publicUrl + "/" + cleanPath(path)
+}
+
+// NewS3 connects to any S3 compatible storage, AWS S3 or MinIO alike.
+// Without STORAGE_S3_PUBLIC_URL files are addressed path-style on the endpoint.
+func NewS3(cfg *config.Config) (StorageInterface, error) {
+	client, err := minio.New(cfg.Storage.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.Storage.S3AccessKey, cfg.Storage.S3SecretKey, ""),
+		Secure: cfg.Storage.S3UseSSL,
+		Region: cfg.Storage.S3Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publicUrl := strings.TrimRight(cfg.Storage.S3PublicUrl, "/")
+	if publicUrl == "" {
+		publicUrl = fmt.Sprintf("%s/%s", client.EndpointURL(), cfg.Storage.S3Bucket)
+	}
+
+	return &s3Struct{
+		client:    client,
+		bucket:    cfg.Storage.S3Bucket,
+		publicUrl: publicUrl,
+	}, nil
+}
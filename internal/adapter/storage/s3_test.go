@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// putRecorder records the PutObject calls the driver makes.
+type putRecorder struct {
+	s3Client
+	sizes   []int64
+	options []minio.PutObjectOptions
+}
+
+func (p *putRecorder) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	p.sizes = append(p.sizes, objectSize)
+	p.options = append(p.options, opts)
+	n, err := io.Copy(io.Discard, reader)
+	return minio.UploadInfo{Bucket: bucketName, Key: objectName, Size: n}, err
+}
+
+func TestS3UploadFileBoundsParts(t *testing.T) {
+	client := &putRecorder{}
+	s := &s3Struct{client: client, bucket: "media", publicUrl: "https://cdn.example.com"}
+
+	url, err := s.UploadFile(context.Background(), "/public/uploads/photo.jpg", bytes.NewReader([]byte("jpeg")), 4, "image/jpeg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if url != "https://cdn.example.com/public/uploads/photo.jpg" {
+		t.Errorf("url = %s", url)
+	}
+	if _, err = s.UploadFile(context.Background(), "private/chunks/1", strings.NewReader("chunk"), -1, "application/octet-stream"); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.sizes[0] != 4 || client.sizes[1] != -1 {
+		t.Errorf("sizes = %v, want the known size and -1", client.sizes)
+	}
+	for i, val := range client.options {
+		if val.PartSize != s3PartSize {
+			t.Errorf("upload %d part size = %d, want %d so unknown sizes are not buffered in huge parts", i, val.PartSize, s3PartSize)
+		}
+	}
+	if client.options[0].ContentType != "image/jpeg" {
+		t.Errorf("content type = %q", client.options[0].ContentType)
+	}
+}
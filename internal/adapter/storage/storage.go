@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"latihan-compro/config"
+	"latihan-compro/utils/tracing"
+	"path/filepath"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	DriverSupabase = "supabase"
+	DriverLocal    = "local"
+	DriverS3       = "s3"
+)
+
+type StorageInterface interface {
+	// path must be filename ex: /img/photo.jpg
+	// file must be io.Reader
+	// size is the length of file, -1 when it is only known once read
+	// contentType is stored with the file and served back as its Content-Type
+	UploadFile(ctx context.Context, path string, file io.Reader, size int64, contentType string) (string, error)
+	DeleteFile(path string) error
+	// OpenFile reads a stored file back, the caller must close it.
+	OpenFile(path string) (io.ReadCloser, error)
+	// Ping checks the storage can be reached, for readiness checks.
+	Ping(ctx context.Context) error
+}
+
+const (
+	// PublicDir holds files anyone may fetch from their storage url.
+	PublicDir = "public"
+	// PrivatePrefix is where files only served through the API are stored,
+	// such as service detail documents behind their signed download url.
+	// Supabase keeps them in a private bucket, an S3 bucket must only grant
+	// public reads under PublicDir.
+	PrivatePrefix = "private/"
+)
+
+// NewStorage returns the driver selected by STORAGE_DRIVER, supabase by default.
+func NewStorage(cfg *config.Config) (StorageInterface, error) {
+	switch cfg.Storage.Driver {
+	case "", DriverSupabase:
+		return NewSupabase(cfg), nil
+	case DriverLocal:
+		return NewLocal(cfg)
+	case DriverS3:
+		return NewS3(cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Storage.Driver)
+	}
+}
+
+// cleanPath normalizes an object path to a slash separated key without a
+// leading slash or any parent directory element.
+func cleanPath(path string) string {
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean("/"+path)), "/")
+}
+
+// startUpload starts the span of an upload to driver.
+func startUpload(ctx context.Context, driver, path, contentType string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "storage.UploadFile",
+		attribute.String("storage.driver", driver),
+		attribute.String("storage.path", path),
+		attribute.String("storage.content_type", contentType),
+	)
+}
@@ -1,39 +1,178 @@
 package storage
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"latihan-compro/config"
+	"latihan-compro/utils/tracing"
+	"net/http"
+	"strings"
+	"time"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 	storage_go "github.com/supabase-community/storage-go"
 )
 
-type SupabaseInterface interface {
-	// path must be filename ex: /img/photo.jpg
-	// file must be io.Reader
-	UploadFile(path string, file io.Reader) (string, error)
-}
-
 type supabaseStruct struct {
-	cfg *config.Config
+	cfg           *config.Config
+	privateBucket string
 }
 
-// UploadFile implements SupabaseInterface.
-func (s *supabaseStruct) UploadFile(path string, file io.Reader) (string, error) {
-	client := storage_go.NewClient(s.cfg.Supabase.StorageUrl, s.cfg.Supabase.StorageKey, map[string]string{"Content-Type": "image/png"})
+// UploadFile implements StorageInterface.
+func (s *supabaseStruct) UploadFile(ctx context.Context, path string, file io.Reader, size int64, contentType string) (string, error) {
+	ctx, span := startUpload(ctx, DriverSupabase, path, contentType)
+	defer span.End()
 
-	_, err := client.UploadFile(s.cfg.Supabase.StorageBucket, path, file)
+	client := storage_go.NewClient(s.cfg.Supabase.StorageUrl, s.cfg.Supabase.StorageKey, map[string]string{"Content-Type": contentType})
+
+	_, err := client.UploadFile(s.bucket(path), path, file, storage_go.FileOptions{ContentType: &contentType})
 	if err != nil {
-		log.Errorf("Error uploading file: %v", err)
+		log.Ctx(ctx).Error().Err(err).Msg("Error uploading file")
+		tracing.Fail(span, err)
 		return "", err
 	}
 
-	result := client.GetPublicUrl(s.cfg.Supabase.StorageBucket, path)
-	return result.SignedURL, nil
+	return s.PublicUrl(path), nil
+}
+
+// Ping implements StorageInterface.
+func (s *supabaseStruct) Ping(ctx context.Context) error {
+	for _, bucket := range []string{s.cfg.Supabase.StorageBucket, s.privateBucket} {
+		if err := s.pingBucket(ctx, bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *supabaseStruct) pingBucket(ctx context.Context, bucket string) error {
+	url := strings.TrimRight(s.cfg.Supabase.StorageUrl, "/") + "/bucket/" + bucket
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.cfg.Supabase.StorageKey)
+	req.Header.Set("apikey", s.cfg.Supabase.StorageKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("storage responded %s for bucket %s", resp.Status, bucket)
+	}
+	return nil
+}
+
+// DeleteFile implements StorageInterface.
+func (s *supabaseStruct) DeleteFile(path string) error {
+	client := storage_go.NewClient(s.cfg.Supabase.StorageUrl, s.cfg.Supabase.StorageKey, nil)
+
+	_, err := client.RemoveFile(s.bucket(path), []string{path})
+	if err != nil {
+		log.Error().Err(err).Msg("Error deleting file")
+		return err
+	}
+	return nil
 }
 
-func NewSupabase(cfg *config.Config) SupabaseInterface {
+// OpenFile implements StorageInterface.
+// The object is streamed, storage_go would read it whole into memory.
+func (s *supabaseStruct) OpenFile(path string) (io.ReadCloser, error) {
+	resp, err := s.objectRequest(http.MethodGet, "/object/", path)
+	if err != nil {
+		log.Error().Err(err).Msg("Error opening file")
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// PresignUpload implements PresignerInterface.
+// Supabase signed upload urls are valid for two hours and bound to the path
+// only, the type and size are checked once the upload is completed.
+func (s *supabaseStruct) PresignUpload(path, contentType string, maxSize int64, expiry time.Duration) (*PresignedUpload, error) {
+	client := storage_go.NewClient(s.cfg.Supabase.StorageUrl, s.cfg.Supabase.StorageKey, nil)
+
+	result, err := client.CreateSignedUploadUrl(s.bucket(path), path)
+	if err != nil {
+		log.Error().Err(err).Msg("Error presigning upload")
+		return nil, err
+	}
+
+	return &PresignedUpload{
+		Method:    http.MethodPut,
+		Url:       strings.TrimRight(s.cfg.Supabase.StorageUrl, "/") + result.Url,
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: time.Now().Add(min(expiry, 2*time.Hour)),
+	}, nil
+}
+
+// StatFile implements PresignerInterface.
+func (s *supabaseStruct) StatFile(path string) (*FileInfo, error) {
+	resp, err := s.objectRequest(http.MethodHead, "/object/authenticated/", path)
+	if err != nil {
+		log.Error().Err(err).Msg("Error reading file info")
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return &FileInfo{
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+	}, nil
+}
+
+// PublicUrl implements PresignerInterface.
+// Private files get the url of their private bucket, which only identifies
+// them, fetching it is refused.
+func (s *supabaseStruct) PublicUrl(path string) string {
+	client := storage_go.NewClient(s.cfg.Supabase.StorageUrl, s.cfg.Supabase.StorageKey, nil)
+	return client.GetPublicUrl(s.bucket(path), path).SignedURL
+}
+
+// bucket returns the bucket path is kept in, the private one for files under
+// PrivatePrefix so they are only served through the API.
+func (s *supabaseStruct) bucket(path string) string {
+	if strings.HasPrefix(cleanPath(path), PrivatePrefix) {
+		return s.privateBucket
+	}
+	return s.cfg.Supabase.StorageBucket
+}
+
+// objectRequest calls the storage api for the object at path with the
+// service key. Any response but 200 is an error.
+func (s *supabaseStruct) objectRequest(method, route, path string) (*http.Response, error) {
+	url := strings.TrimRight(s.cfg.Supabase.StorageUrl, "/") + route + s.bucket(path) + "/" + cleanPath(path)
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.cfg.Supabase.StorageKey)
+	req.Header.Set("apikey", s.cfg.Supabase.StorageKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("storage responded %s for %s", resp.Status, path)
+	}
+	return resp, nil
+}
+
+func NewSupabase(cfg *config.Config) StorageInterface {
+	privateBucket := cfg.Supabase.StoragePrivateBucket
+	if privateBucket == "" {
+		privateBucket = cfg.Supabase.StorageBucket + "-private"
+	}
+
 	return &supabaseStruct{
-		cfg: cfg,
+		cfg:           cfg,
+		privateBucket: privateBucket,
 	}
 }
@@ -2,30 +2,62 @@ package app
 
 import (
 	"context"
+	"errors"
 	"latihan-compro/config"
+	"latihan-compro/database/migrations"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/handler"
 	"latihan-compro/internal/adapter/messaging"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/adapter/storage"
 	"latihan-compro/internal/core/service"
 	"latihan-compro/utils/auth"
+	"latihan-compro/utils/health"
+	"latihan-compro/utils/logger"
+	"latihan-compro/utils/metrics"
+	appMiddleware "latihan-compro/utils/middleware"
+	"latihan-compro/utils/tracing"
 	"latihan-compro/utils/validator"
-	"log"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
-	en "github.com/go-playground/validator/v10/translations/en"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/rs/zerolog/log"
 )
 
 func RunServer() {
 	cfg := config.NewConfig()
+	logger.Init(cfg)
+
+	shutdownTracing, err := tracing.Init(context.Background(), cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("[APP] RunServer - 1: Error initializing tracing")
+		return
+	}
+
 	db, err := cfg.ConnectionPostgres()
 	if err != nil {
-		log.Fatal("Error connecting to database: %v", err)
+		log.Fatal().Err(err).Msg("[APP] RunServer - 2: Error connecting to database")
+		return
+	}
+
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		log.Fatal().Err(err).Msg("[APP] RunServer - 3: Error getting database connection")
+		return
+	}
+
+	if err := metrics.InstrumentDB(db.DB, cfg.Psql.DBName); err != nil {
+		log.Fatal().Err(err).Msg("[APP] RunServer - 4: Error instrumenting database")
+		return
+	}
+	if err := tracing.InstrumentDB(db.DB); err != nil {
+		log.Fatal().Err(err).Msg("[APP] RunServer - 5: Error instrumenting database")
 		return
 	}
 
@@ -46,50 +78,110 @@ func RunServer() {
 	portofolioTestimonialRepo := repository.NewPortofolioTestimonialRepository(db.DB)
 	contactUsRepo := repository.NewContactUsRepository(db.DB)
 	serviceDetailRepo := repository.NewServiceDetailRepository(db.DB)
+	revisionRepo := repository.NewContentRevisionRepository(db.DB)
+	auditLogRepo := repository.NewAuditLogRepository(db.DB)
+	trashRepo := repository.NewTrashRepository(db.DB)
+	positionRepo := repository.NewContentPositionRepository(db.DB)
+	translationRepo := repository.NewContentTranslationRepository(db.DB)
+	mediaAssetRepo := repository.NewMediaAssetRepository(db.DB)
+	chunkedUploadRepo := repository.NewChunkedUploadRepository(db.DB)
+
+	storageAdapter, err := storage.NewStorage(cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("[APP] RunServer - 6: Error initializing storage")
+		return
+	}
+
+	cacheAdapter, err := cache.NewCache(cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("[APP] RunServer - 7: Error initializing cache")
+		return
+	}
+
+	healthChecker := health.NewHealth(cfg.Health.CheckTimeout, cfg.Health.CacheTTL,
+		health.Database(db.DB),
+		health.Migrations(migrations.NewMigrator(sqlDB, migrations.FS)),
+		health.NewChecker("storage", storageAdapter.Ping),
+		// Only appointments send mail, the rest of the site works without it.
+		health.Optional(health.NewChecker("smtp", emailMessage.Ping)),
+	)
 
 	userService := service.NewUserService(userRepo, cfg, jwt)
-	heroSectionService := service.NewHeroSectionService(heroSectionRepo)
-	clientSectionService := service.NewClientSectionService(clientSectionRepo)
-	aboutCompanyService := service.NewAboutCompanyService(aboutCompanyRepo)
-	faqService := service.NewFaqSectionService(faqRepo)
-	ourTeamService := service.NewOurTeamService(ourTeamRepo)
-	aboutCompanyKeynoteService := service.NewAboutCompanyKeynoteService(aboutCompanyKeynoteRepo, aboutCompanyRepo)
-	serviceSectionService := service.NewServiceSectionService(serviceSectionRepo)
+	heroSectionService := service.NewHeroSectionService(heroSectionRepo, revisionRepo, cacheAdapter)
+	clientSectionService := service.NewClientSectionService(clientSectionRepo, revisionRepo, cacheAdapter)
+	aboutCompanyService := service.NewAboutCompanyService(aboutCompanyRepo, revisionRepo, cacheAdapter)
+	faqService := service.NewFaqSectionService(faqRepo, revisionRepo, cacheAdapter)
+	ourTeamService := service.NewOurTeamService(ourTeamRepo, revisionRepo, cacheAdapter)
+	aboutCompanyKeynoteService := service.NewAboutCompanyKeynoteService(aboutCompanyKeynoteRepo, aboutCompanyRepo, revisionRepo, cacheAdapter)
+	serviceSectionService := service.NewServiceSectionService(serviceSectionRepo, revisionRepo, cacheAdapter)
 	appointmentService := service.NewAppointmentService(appointmentRepo, emailMessage)
-	portofolioService := service.NewPortofolioSectionService(portofolioRepo)
-	portofolioDetailService := service.NewPortofolioDetailService(portofolioDetailRepo, portofolioRepo)
-	portofolioTestimonialService := service.NewPortofolioTestimonialService(portofolioTestimonialRepo, portofolioRepo)
-	contactUsService := service.NewContactUsService(contactUsRepo)
-	serviceDetailService := service.NewServiceDetailService(serviceDetailRepo)
-
-	storageAdapter := storage.NewSupabase(cfg)
+	portofolioService := service.NewPortofolioSectionService(portofolioRepo, revisionRepo, cacheAdapter)
+	portofolioDetailService := service.NewPortofolioDetailService(portofolioDetailRepo, portofolioRepo, revisionRepo, cacheAdapter)
+	portofolioTestimonialService := service.NewPortofolioTestimonialService(portofolioTestimonialRepo, portofolioRepo, revisionRepo, cacheAdapter)
+	contactUsService := service.NewContactUsService(contactUsRepo, revisionRepo, cacheAdapter)
+	serviceDetailService := service.NewServiceDetailService(serviceDetailRepo, revisionRepo, mediaAssetRepo, storageAdapter)
+	revisionService := service.NewContentRevisionService(revisionRepo, cacheAdapter)
+	auditLogService := service.NewAuditLogService(auditLogRepo, cfg)
+	trashService := service.NewTrashService(trashRepo, cacheAdapter, cfg)
+	positionService := service.NewContentPositionService(positionRepo, cacheAdapter)
+	translationService := service.NewContentTranslationService(translationRepo, cacheAdapter, cfg)
+	mediaAssetService := service.NewMediaAssetService(mediaAssetRepo, storageAdapter, cfg)
+	chunkedUploadService := service.NewChunkedUploadService(chunkedUploadRepo, mediaAssetRepo, storageAdapter, cfg)
+	siteService := service.NewSiteService(heroSectionService, clientSectionService, aboutCompanyService, serviceSectionService, portofolioService, portofolioTestimonialService, ourTeamService, faqService, contactUsService)
 
 	e := echo.New()
-	e.Use(middleware.CORS())
+	e.HTTPErrorHandler = handler.HTTPErrorHandler
+	e.Use(appMiddleware.RequestID())
+	e.Use(appMiddleware.Tracing())
+	e.Use(appMiddleware.Metrics())
+	e.Use(appMiddleware.AccessLog(cfg))
+	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		ExposeHeaders: handler.TusHeaders,
+	}))
+	e.Use(appMiddleware.Deadline(cfg))
+	e.Use(appMiddleware.AuditLog(auditLogService))
+	e.Use(appMiddleware.ResponseCache(cacheAdapter, cfg, handler.CachedRoutes))
 
 	customValidator := validator.NewValidator()
-	en.RegisterDefaultTranslations(customValidator.Validator, customValidator.Translator)
 	e.Validator = customValidator
 
-	e.GET("/api/check", func(c echo.Context) error {
-		return c.String(200, "OK")
+	if cfg.Storage.Driver == storage.DriverLocal {
+		// Only public files are served, private ones go through the API.
+		route, root := storage.LocalRoute(cfg)
+		e.Static(route+"/"+storage.PublicDir, filepath.Join(root, storage.PublicDir))
+	}
+
+	handler.RegisterRoutes(e, cfg, handler.Services{
+		User:                  userService,
+		MediaAsset:            mediaAssetService,
+		ChunkedUpload:         chunkedUploadService,
+		HeroSection:           heroSectionService,
+		ClientSection:         clientSectionService,
+		AboutCompany:          aboutCompanyService,
+		FaqSection:            faqService,
+		OurTeam:               ourTeamService,
+		AboutCompanyKeynote:   aboutCompanyKeynoteService,
+		ServiceSection:        serviceSectionService,
+		Appointment:           appointmentService,
+		PortofolioSection:     portofolioService,
+		PortofolioDetail:      portofolioDetailService,
+		PortofolioTestimonial: portofolioTestimonialService,
+		ContactUs:             contactUsService,
+		ServiceDetail:         serviceDetailService,
+		ContentRevision:       revisionService,
+		AuditLog:              auditLogService,
+		Trash:                 trashService,
+		ContentPosition:       positionService,
+		ContentTranslation:    translationService,
+		Site:                  siteService,
+		Health:                healthChecker,
 	})
 
-	handler.NewUserHandler(e, userService)
-	handler.NewUploadImage(e, storageAdapter, cfg)
-	handler.NewHeroSectionHandler(e, cfg, heroSectionService)
-	handler.NewClientSectionHandler(e, clientSectionService, cfg)
-	handler.NewAboutCompanyHandler(e, aboutCompanyService, cfg)
-	handler.NewFaqSectionHandler(e, faqService, cfg)
-	handler.NewOurTeamHandler(e, cfg, ourTeamService)
-	handler.NewAboutCompanyKeynoteHandler(e, aboutCompanyKeynoteService, cfg)
-	handler.NewServiceSectionHandler(e, serviceSectionService, cfg)
-	handler.NewAppointmentHandler(e, appointmentService, cfg)
-	handler.NewPortofolioSectionHandler(e, portofolioService, cfg)
-	handler.NewPortofolioDetailHandler(e, portofolioDetailService, cfg)
-	handler.NewPortofolioTestimonialHandler(e, portofolioTestimonialService, cfg)
-	handler.NewContactUsHandler(e, contactUsService, cfg)
-	handler.NewServiceDetailHandler(e, serviceDetailService, cfg)
+	retentionCtx, stopRetention := context.WithCancel(context.Background())
+	defer stopRetention()
+	go auditLogService.StartRetention(retentionCtx, 24*time.Hour)
+	go trashService.StartRetention(retentionCtx, 24*time.Hour)
+	go chunkedUploadService.StartRetention(retentionCtx, time.Hour)
 
 	// Starting server
 	go func() {
@@ -98,8 +190,8 @@ func RunServer() {
 		}
 
 		err := e.Start(":" + cfg.App.AppPort)
-		if err != nil {
-			log.Fatal("error starting server: ", err)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal().Err(err).Msg("[APP] RunServer - 8: Error starting server")
 		}
 	}()
 	quit := make(chan os.Signal, 1)
@@ -109,11 +201,22 @@ func RunServer() {
 	// Block until a signal is received.
 	<-quit
 
-	log.Println("server shutdown of 5 second.")
+	// Fail readiness first, load balancers stop routing here during the delay.
+	healthChecker.Shutdown()
+	if cfg.Health.ShutdownDelay > 0 {
+		log.Info().Dur("delay", cfg.Health.ShutdownDelay).Msg("[APP] RunServer: readiness failing before shutdown")
+		time.Sleep(cfg.Health.ShutdownDelay)
+	}
+
+	log.Info().Msg("[APP] RunServer: server shutdown of 5 second.")
 
 	// gracefully shutdown the server, waiting max 5 seconds for current operations to complete
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	e.Shutdown(ctx)
+
+	if err := shutdownTracing(ctx); err != nil {
+		log.Error().Err(err).Msg("[APP] RunServer - 9: Error flushing traces")
+	}
 }
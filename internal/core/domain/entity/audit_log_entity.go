@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+)
+
+type AuditLogEntity struct {
+	ID          int64
+	ActorID     int64
+	Action      string
+	EntityType  string
+	EntityID    int64
+	BeforeValue *string
+	AfterValue  *string
+	IpAddress   string
+	UserAgent   string
+	CreatedAt   time.Time
+}
+
+type AuditLogFilterEntity struct {
+	ActorID    int64
+	Action     string
+	EntityType string
+	EntityID   int64
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	PerPage    int
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// ChunkedUploadEntity is a resumable upload received in consecutive chunks.
+// Every chunk is stored as its own object until the last one arrives.
+type ChunkedUploadEntity struct {
+	ID           string
+	UploaderID   int64
+	FileName     string
+	Length       int64
+	Offset       int64
+	ChunkPaths   []string
+	ContentType  string
+	Extension    string
+	MediaAssetID int64
+	Url          string
+	CompletedAt  *time.Time
+	CreatedAt    time.Time
+}
+
+// ChunkEntity is a chunk appended at Offset, stored at Path.
+type ChunkEntity struct {
+	Offset      int64
+	Size        int64
+	Path        string
+	ContentType string
+	Extension   string
+}
@@ -2,6 +2,7 @@ package entity
 
 type ClientSectionEntity struct {
 	ID       int64
+	Position int64
 	Name     string
 	PathIcon string
 }
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type ContentRevisionEntity struct {
+	ID          int64
+	ContentType string
+	ContentID   int64
+	Version     int64
+	Snapshot    string
+	AuthorID    int64
+	Note        string
+	CreatedAt   time.Time
+}
+
+type ContentRevisionDiffEntity struct {
+	Field string
+	From  interface{}
+	To    interface{}
+}
@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// TranslatableFields lists, per content type, the columns that can be
+// translated. The row itself always holds the default locale.
+var TranslatableFields = map[string][]string{
+	ContentTypeHeroSection:           {"heading", "sub_heading"},
+	ContentTypeAboutCompany:          {"description"},
+	ContentTypeAboutCompanyKeynote:   {"keypoint"},
+	ContentTypeFaqSection:            {"title", "description"},
+	ContentTypeOurTeam:               {"role", "tagline"},
+	ContentTypeServiceSection:        {"name", "tagline"},
+	ContentTypeServiceDetail:         {"title", "description"},
+	ContentTypePortofolioSection:     {"name", "tagline"},
+	ContentTypePortofolioDetail:      {"category", "title", "description"},
+	ContentTypePortofolioTestimonial: {"message", "role"},
+	ContentTypeContactUs:             {"location_name", "address"},
+}
+
+func IsTranslatableField(contentType, field string) bool {
+	for _, val := range TranslatableFields[contentType] {
+		if val == field {
+			return true
+		}
+	}
+	return false
+}
+
+type ContentTranslationEntity struct {
+	ID          int64
+	ContentType string
+	ContentID   int64
+	Locale      string
+	Field       string
+	Value       string
+	UpdatedAt   time.Time
+}
+
+type MissingTranslationEntity struct {
+	ContentType string
+	ContentID   int64
+	Locale      string
+	Fields      []string
+}
@@ -0,0 +1,54 @@
+package entity
+
+// Content types identify the CMS sections by the name of their route group,
+// so generic features (revisions, trash, ...) can address any of them.
+const (
+	ContentTypeHeroSection           = "hero-sections"
+	ContentTypeClientSection         = "client-sections"
+	ContentTypeAboutCompany          = "about-company"
+	ContentTypeAboutCompanyKeynote   = "about-company-keynotes"
+	ContentTypeFaqSection            = "faq-sections"
+	ContentTypeOurTeam               = "our-teams"
+	ContentTypeServiceSection        = "service-sections"
+	ContentTypeServiceDetail         = "service-details"
+	ContentTypePortofolioSection     = "portofolio-sections"
+	ContentTypePortofolioDetail      = "portofolio-details"
+	ContentTypePortofolioTestimonial = "portofolio-testimonials"
+	ContentTypeContactUs             = "contact-us"
+	ContentTypeAppointment           = "appointments"
+)
+
+// ContentTypes lists every content type, in the order their handlers are registered.
+var ContentTypes = []string{
+	ContentTypeHeroSection,
+	ContentTypeClientSection,
+	ContentTypeAboutCompany,
+	ContentTypeFaqSection,
+	ContentTypeOurTeam,
+	ContentTypeAboutCompanyKeynote,
+	ContentTypeServiceSection,
+	ContentTypeAppointment,
+	ContentTypePortofolioSection,
+	ContentTypePortofolioDetail,
+	ContentTypePortofolioTestimonial,
+	ContentTypeContactUs,
+	ContentTypeServiceDetail,
+}
+
+// SortableContentTypes lists the content types ordered manually by position.
+var SortableContentTypes = []string{
+	ContentTypeClientSection,
+	ContentTypeFaqSection,
+	ContentTypeOurTeam,
+	ContentTypeServiceSection,
+	ContentTypePortofolioSection,
+}
+
+func IsSortableContentType(contentType string) bool {
+	for _, sortable := range SortableContentTypes {
+		if sortable == contentType {
+			return true
+		}
+	}
+	return false
+}
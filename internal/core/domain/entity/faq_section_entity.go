@@ -2,6 +2,7 @@ package entity
 
 type FaqSectionEntity struct {
 	ID          int64
+	Position    int64
 	Title       string
 	Description string
 }
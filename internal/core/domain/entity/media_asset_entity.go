@@ -0,0 +1,51 @@
+package entity
+
+import "time"
+
+type MediaAssetEntity struct {
+	ID           int64
+	Path         string
+	Url          string
+	ContentType  string
+	Size         int64
+	Width        int64
+	Height       int64
+	VariantPaths []string
+	UploaderID   int64
+	AltText      string
+	CreatedAt    time.Time
+}
+
+type MediaAssetFilterEntity struct {
+	Search      string
+	ContentType string
+	Page        int
+	PerPage     int
+}
+
+// MediaAssetReferenceEntity is a content column pointing at a media asset.
+type MediaAssetReferenceEntity struct {
+	ContentType string
+	ContentID   int64
+	Field       string
+}
+
+// PresignedUploadEntity lets a client upload one file straight to storage.
+// Path, Expires and Signature are sent back to complete the upload.
+type PresignedUploadEntity struct {
+	Method    string
+	Url       string
+	Fields    map[string]string
+	Headers   map[string]string
+	Path      string
+	Expires   string
+	Signature string
+	ExpiresAt time.Time
+}
+
+type CompletePresignedUploadEntity struct {
+	Path      string
+	Expires   string
+	Signature string
+	AltText   string
+}
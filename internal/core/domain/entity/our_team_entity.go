@@ -2,6 +2,7 @@ package entity
 
 type OurTeamEntity struct {
 	ID        int64
+	Position  int64
 	Name      string
 	Role      string
 	PathPhoto string
@@ -2,6 +2,7 @@ package entity
 
 type PortofolioSectionEntity struct {
 	ID        int64
+	Position  int64
 	Name      string
 	Tagline   string
 	Thumbnail string
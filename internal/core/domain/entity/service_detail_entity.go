@@ -1,5 +1,10 @@
 package entity
 
+import (
+	"io"
+	"time"
+)
+
 type ServiceDetailEntity struct {
 	ID          int64
 	ServiceID   int64
@@ -10,3 +15,25 @@ type ServiceDetailEntity struct {
 	PathDocx    *string
 	ServiceName string
 }
+
+const (
+	ServiceDetailFilePdf  = "pdf"
+	ServiceDetailFileDocx = "docx"
+)
+
+// ServiceDetailFileEntity is a service detail document opened for download.
+// Reader is nil for files stored outside the media library, which are
+// redirected to Url instead.
+type ServiceDetailFileEntity struct {
+	FileName    string
+	ContentType string
+	Size        int64
+	Url         string
+	Reader      io.ReadCloser
+}
+
+type ServiceDetailDownloadEntity struct {
+	FileType         string
+	Total            int64
+	LastDownloadedAt *time.Time
+}
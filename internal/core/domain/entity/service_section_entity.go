@@ -2,6 +2,7 @@ package entity
 
 type ServiceSectionEntity struct {
 	ID            int64
+	Position      int64
 	PathIcon      string
 	Name          string
 	Tagline       string
@@ -0,0 +1,41 @@
+package entity
+
+// Sections of the public site, as selected with ?include= on /site.
+const (
+	SiteHeroSection            = "hero_section"
+	SiteClientSections         = "client_sections"
+	SiteAboutCompany           = "about_company"
+	SiteServiceSections        = "service_sections"
+	SitePortofolioSections     = "portofolio_sections"
+	SitePortofolioTestimonials = "portofolio_testimonials"
+	SiteOurTeams               = "our_teams"
+	SiteFaqSections            = "faq_sections"
+	SiteContactUs              = "contact_us"
+)
+
+var SiteSections = []string{
+	SiteHeroSection,
+	SiteClientSections,
+	SiteAboutCompany,
+	SiteServiceSections,
+	SitePortofolioSections,
+	SitePortofolioTestimonials,
+	SiteOurTeams,
+	SiteFaqSections,
+	SiteContactUs,
+}
+
+// SiteEntity gathers the public sections of the home page. A section that
+// failed to load is left empty and its error kept in Errors by section name.
+type SiteEntity struct {
+	HeroSection            *HeroSectionEntity
+	ClientSections         []ClientSectionEntity
+	AboutCompany           *AboutCompanyEntity
+	ServiceSections        []ServiceSectionEntity
+	PortofolioSections     []PortofolioSectionEntity
+	PortofolioTestimonials []PortofolioTestimonialEntity
+	OurTeams               []OurTeamEntity
+	FaqSections            []FaqSectionEntity
+	ContactUs              *ContactUsEntity
+	Errors                 map[string]error
+}
@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type TrashEntity struct {
+	ContentType string
+	ID          int64
+	Snapshot    string
+	DeletedAt   time.Time
+}
@@ -0,0 +1,133 @@
+// Package errs defines the kinds of failure the core reports to its callers.
+// Adapters translate their own errors (GORM, pgx, ...) into an *Error so the
+// HTTP layer can map a kind to a status without knowing where it came from.
+package errs
+
+import (
+	"context"
+	"errors"
+)
+
+// Kind classifies an error, its value is the default machine readable code.
+type Kind string
+
+const (
+	KindInternal       Kind = "internal_error"
+	KindNotFound       Kind = "not_found"
+	KindConflict       Kind = "conflict"
+	KindValidation     Kind = "validation_failed"
+	KindUnauthorized   Kind = "unauthorized"
+	KindForbidden      Kind = "forbidden"
+	KindRateLimited    Kind = "rate_limited"
+	KindNotImplemented Kind = "not_implemented"
+	KindTimeout        Kind = "timeout"
+)
+
+// Sentinels match any *Error of their kind with errors.Is.
+var (
+	ErrNotFound       = &Error{Kind: KindNotFound}
+	ErrConflict       = &Error{Kind: KindConflict}
+	ErrValidation     = &Error{Kind: KindValidation}
+	ErrUnauthorized   = &Error{Kind: KindUnauthorized}
+	ErrForbidden      = &Error{Kind: KindForbidden}
+	ErrRateLimited    = &Error{Kind: KindRateLimited}
+	ErrNotImplemented = &Error{Kind: KindNotImplemented}
+)
+
+// Error is a failure of a known kind. Code is a stable identifier clients can
+// switch on, Message is safe to show them and Err keeps the cause for logs.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return string(e.Kind)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is matches a target of the same kind, and of the same code when the target
+// has one, so both the kind sentinels and coded errors work with errors.Is.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return e.Kind == t.Kind && (t.Code == "" || e.Code == t.Code)
+}
+
+// New returns an error of kind, code defaults to the kind.
+func New(kind Kind, code, message string) *Error {
+	if code == "" {
+		code = string(kind)
+	}
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+// Wrap returns an error of kind caused by err.
+func Wrap(err error, kind Kind, code, message string) *Error {
+	e := New(kind, code, message)
+	e.Err = err
+	return e
+}
+
+func NotFound(code, message string) *Error {
+	return New(KindNotFound, code, message)
+}
+
+func Conflict(code, message string) *Error {
+	return New(KindConflict, code, message)
+}
+
+func Validation(code, message string) *Error {
+	return New(KindValidation, code, message)
+}
+
+func Unauthorized(code, message string) *Error {
+	return New(KindUnauthorized, code, message)
+}
+
+func Forbidden(code, message string) *Error {
+	return New(KindForbidden, code, message)
+}
+
+func RateLimited(code, message string) *Error {
+	return New(KindRateLimited, code, message)
+}
+
+// KindOf returns the kind of the first *Error in err's chain. Deadlines are
+// reported as KindTimeout and anything else as KindInternal.
+func KindOf(err error) Kind {
+	var e *Error
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &e):
+		return e.Kind
+	case errors.Is(err, context.DeadlineExceeded):
+		return KindTimeout
+	default:
+		return KindInternal
+	}
+}
+
+// CodeOf returns the code of the first *Error in err's chain, or the code of
+// its kind.
+func CodeOf(err error) string {
+	var e *Error
+	if errors.As(err, &e) && e.Code != "" {
+		return e.Code
+	}
+	return string(KindOf(err))
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type AuditLog struct {
+	ID          int64 `gorm:"id,primaryKey"`
+	ActorID     *int64
+	Action      string
+	EntityType  string
+	EntityID    *int64
+	BeforeValue *string `gorm:"type:jsonb"`
+	AfterValue  *string `gorm:"type:jsonb"`
+	IpAddress   string
+	UserAgent   string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type ChunkedUpload struct {
+	ID           string `gorm:"id,primaryKey"`
+	UploaderID   *int64
+	FileName     *string
+	Length       int64
+	UploadOffset int64
+	ChunkPaths   string `gorm:"type:jsonb"`
+	ContentType  *string
+	Extension    *string
+	MediaAssetID *int64
+	CompletedAt  *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    *time.Time
+}
@@ -10,6 +10,7 @@ type ClientSection struct {
 	ID        int64 `gorm:"id,primaryKey"`
 	Name      string
 	PathIcon  string
+	Position  int64
 	CreatedAt time.Time
 	UpdatedAt *time.Time
 	DeletedAt gorm.DeletedAt `gorm:"index"`
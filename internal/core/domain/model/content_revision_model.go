@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type ContentRevision struct {
+	ID          int64 `gorm:"id,primaryKey"`
+	ContentType string
+	ContentID   int64
+	Version     int64
+	Snapshot    string `gorm:"type:jsonb"`
+	AuthorID    *int64
+	Note        *string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type ContentTranslation struct {
+	ID          int64 `gorm:"id,primaryKey"`
+	ContentType string
+	ContentID   int64
+	Locale      string
+	Field       string
+	Value       string
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
@@ -10,6 +10,7 @@ type FaqSection struct {
 	ID          int64 `gorm:"id,primaryKey"`
 	Title       string
 	Description string
+	Position    int64
 	CreatedAt   time.Time
 	UpdatedAt   *time.Time
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type MediaAsset struct {
+	ID           int64 `gorm:"id,primaryKey"`
+	Path         string
+	Url          string
+	ContentType  string
+	Size         int64
+	Width        *int64
+	Height       *int64
+	VariantPaths string `gorm:"type:jsonb"`
+	UploaderID   *int64
+	AltText      *string
+	CreatedAt    time.Time
+	UpdatedAt    *time.Time
+}
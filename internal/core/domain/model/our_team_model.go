@@ -12,6 +12,7 @@ type OurTeam struct {
 	Role      string
 	PathPhoto string
 	Tagline   string
+	Position  int64
 	CreatedAt time.Time
 	UpdatedAt *time.Time
 	DeletedAt gorm.DeletedAt `gorm:"index"`
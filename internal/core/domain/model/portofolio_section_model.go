@@ -11,6 +11,7 @@ type PortofolioSection struct {
 	Name      string
 	Tagline   string
 	Thumbnail *string
+	Position  int64
 	CreatedAt time.Time
 	UpdatedAt *time.Time
 	DeletedAt gorm.DeletedAt `gorm:"index"`
@@ -18,3 +18,11 @@ type ServiceDetail struct {
 	UpdatedAt   *time.Time
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
+
+type ServiceDetailDownload struct {
+	ID               int64 `gorm:"id,primaryKey"`
+	ServiceDetailID  int64
+	FileType         string
+	Total            int64
+	LastDownloadedAt *time.Time
+}
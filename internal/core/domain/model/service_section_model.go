@@ -11,6 +11,7 @@ type ServiceSection struct {
 	PathIcon  string
 	Name      string
 	Tagline   string
+	Position  int64
 	CreatedAt time.Time
 	UpdatedAt *time.Time
 	DeletedAt gorm.DeletedAt `gorm:"index"`
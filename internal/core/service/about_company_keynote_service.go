@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type AboutCompanyKeynoteServiceInterface interface {
@@ -19,51 +21,81 @@ type AboutCompanyKeynoteServiceInterface interface {
 type aboutCompanyKeynoteService struct {
 	aboutCompanyKeynoteRepo repository.AboutCompanyKeynoteInterface
 	aboutCompanyRepo        repository.AboutCompanyInterface
+	revisionRepo            repository.ContentRevisionRepositoryInterface
+	cacheAdapter            cache.CacheInterface
 }
 
 // CreateAboutCompanyKeynote implements AboutCompanyKeynoteServiceInterface.
-func (c *aboutCompanyKeynoteService) CreateAboutCompanyKeynote(ctx context.Context, req entity.AboutCompanyKeynoteEntity) error {
-	_, err := c.aboutCompanyRepo.FetchByIDAboutCompany(ctx, req.AboutCompanyID)
+func (c *aboutCompanyKeynoteService) CreateAboutCompanyKeynote(ctx context.Context, req entity.AboutCompanyKeynoteEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyKeynoteService.CreateAboutCompanyKeynote")
+	defer tracing.End(span, &err)
+
+	_, err = c.aboutCompanyRepo.FetchByIDAboutCompany(ctx, req.AboutCompanyID)
 	if err != nil {
-		log.Errorf("[SERVICE] CreateAboutCompanyKeynote - 1: %v", err)
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CreateAboutCompanyKeynote - 1")
 		return err
 	}
-	return c.aboutCompanyKeynoteRepo.CreateAboutCompanyKeynote(ctx, req)
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeAboutCompanyKeynote, func() error {
+		return c.aboutCompanyKeynoteRepo.CreateAboutCompanyKeynote(ctx, req)
+	})
 }
 
 // EditByIDAboutCompanyKeynote implements AboutCompanyKeynoteServiceInterface.
-func (c *aboutCompanyKeynoteService) EditByIDAboutCompanyKeynote(ctx context.Context, req entity.AboutCompanyKeynoteEntity) error {
-	_, err := c.aboutCompanyRepo.FetchByIDAboutCompany(ctx, req.AboutCompanyID)
+func (c *aboutCompanyKeynoteService) EditByIDAboutCompanyKeynote(ctx context.Context, req entity.AboutCompanyKeynoteEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyKeynoteService.EditByIDAboutCompanyKeynote")
+	defer tracing.End(span, &err)
+
+	_, err = c.aboutCompanyRepo.FetchByIDAboutCompany(ctx, req.AboutCompanyID)
 	if err != nil {
-		log.Errorf("[SERVICE] EditByIDAboutCompanyKeynote - 1: %v", err)
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] EditByIDAboutCompanyKeynote - 1")
 		return err
 	}
-	return c.aboutCompanyKeynoteRepo.EditByIDAboutCompanyKeynote(ctx, req)
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeAboutCompanyKeynote, func() error {
+		return trackRevision(ctx, c.revisionRepo, entity.ContentTypeAboutCompanyKeynote, req.ID, func(ctx context.Context) error {
+			return c.aboutCompanyKeynoteRepo.EditByIDAboutCompanyKeynote(ctx, req)
+		})
+	})
 }
 
 // DeleteByIDAboutCompanyKeynote implements AboutCompanyKeynoteServiceInterface.
-func (c *aboutCompanyKeynoteService) DeleteByIDAboutCompanyKeynote(ctx context.Context, id int64) error {
-	return c.aboutCompanyKeynoteRepo.DeleteByIDAboutCompanyKeynote(ctx, id)
+func (c *aboutCompanyKeynoteService) DeleteByIDAboutCompanyKeynote(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyKeynoteService.DeleteByIDAboutCompanyKeynote")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeAboutCompanyKeynote, func() error {
+		return c.aboutCompanyKeynoteRepo.DeleteByIDAboutCompanyKeynote(ctx, id)
+	})
 }
 
 // FetchAllAboutCompanyKeynote implements AboutCompanyKeynoteServiceInterface.
-func (c *aboutCompanyKeynoteService) FetchAllAboutCompanyKeynote(ctx context.Context) ([]entity.AboutCompanyKeynoteEntity, error) {
+func (c *aboutCompanyKeynoteService) FetchAllAboutCompanyKeynote(ctx context.Context) (_ []entity.AboutCompanyKeynoteEntity, err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyKeynoteService.FetchAllAboutCompanyKeynote")
+	defer tracing.End(span, &err)
+
 	return c.aboutCompanyKeynoteRepo.FetchAllAboutCompanyKeynote(ctx)
 }
 
 // FetchByIDAboutCompanyKeynote implements AboutCompanyKeynoteServiceInterface.
-func (c *aboutCompanyKeynoteService) FetchByIDAboutCompanyKeynote(ctx context.Context, id int64) (*entity.AboutCompanyKeynoteEntity, error) {
+func (c *aboutCompanyKeynoteService) FetchByIDAboutCompanyKeynote(ctx context.Context, id int64) (_ *entity.AboutCompanyKeynoteEntity, err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyKeynoteService.FetchByIDAboutCompanyKeynote")
+	defer tracing.End(span, &err)
+
 	return c.aboutCompanyKeynoteRepo.FetchByIDAboutCompanyKeynote(ctx, id)
 }
 
 // FetchByCompanyID implements AboutCompanyKeynoteServiceInterface.
-func (c *aboutCompanyKeynoteService) FetchByCompanyID(ctx context.Context, companyId int64) ([]entity.AboutCompanyKeynoteEntity, error) {
+func (c *aboutCompanyKeynoteService) FetchByCompanyID(ctx context.Context, companyId int64) (_ []entity.AboutCompanyKeynoteEntity, err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyKeynoteService.FetchByCompanyID")
+	defer tracing.End(span, &err)
+
 	return c.aboutCompanyKeynoteRepo.FetchByCompanyID(ctx, companyId)
 }
 
-func NewAboutCompanyKeynoteService(aboutCompanyKeynoteRepo repository.AboutCompanyKeynoteInterface, aboutCompanyRepo repository.AboutCompanyInterface) AboutCompanyKeynoteServiceInterface {
+func NewAboutCompanyKeynoteService(aboutCompanyKeynoteRepo repository.AboutCompanyKeynoteInterface, aboutCompanyRepo repository.AboutCompanyInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) AboutCompanyKeynoteServiceInterface {
 	return &aboutCompanyKeynoteService{
 		aboutCompanyKeynoteRepo: aboutCompanyKeynoteRepo,
 		aboutCompanyRepo:        aboutCompanyRepo,
+		revisionRepo:            revisionRepo,
+		cacheAdapter:            cacheAdapter,
 	}
 }
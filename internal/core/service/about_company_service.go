@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 )
 
 type AboutCompanyServiceInterface interface {
@@ -17,40 +19,70 @@ type AboutCompanyServiceInterface interface {
 
 type aboutCompanyService struct {
 	aboutCompanyRepo repository.AboutCompanyInterface
+	revisionRepo     repository.ContentRevisionRepositoryInterface
+	cacheAdapter     cache.CacheInterface
 }
 
 // FetchAllCompanyAndKeynote implements AboutCompanyServiceInterface.
-func (c *aboutCompanyService) FetchAllCompanyAndKeynote(ctx context.Context) (*entity.AboutCompanyEntity, error) {
+func (c *aboutCompanyService) FetchAllCompanyAndKeynote(ctx context.Context) (_ *entity.AboutCompanyEntity, err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyService.FetchAllCompanyAndKeynote")
+	defer tracing.End(span, &err)
+
 	return c.aboutCompanyRepo.FetchAllCompanyAndKeynote(ctx)
 }
 
 // CreateAboutCompany implements AboutCompanyServiceInterface.
-func (c *aboutCompanyService) CreateAboutCompany(ctx context.Context, req entity.AboutCompanyEntity) error {
-	return c.aboutCompanyRepo.CreateAboutCompany(ctx, req)
+func (c *aboutCompanyService) CreateAboutCompany(ctx context.Context, req entity.AboutCompanyEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyService.CreateAboutCompany")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeAboutCompany, func() error {
+		return c.aboutCompanyRepo.CreateAboutCompany(ctx, req)
+	})
 }
 
 // DeleteByIDAboutCompany implements AboutCompanyServiceInterface.
-func (c *aboutCompanyService) DeleteByIDAboutCompany(ctx context.Context, id int64) error {
-	return c.aboutCompanyRepo.DeleteByIDAboutCompany(ctx, id)
+func (c *aboutCompanyService) DeleteByIDAboutCompany(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyService.DeleteByIDAboutCompany")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeAboutCompany, func() error {
+		return c.aboutCompanyRepo.DeleteByIDAboutCompany(ctx, id)
+	})
 }
 
 // EditByIDAboutCompany implements AboutCompanyServiceInterface.
-func (c *aboutCompanyService) EditByIDAboutCompany(ctx context.Context, req entity.AboutCompanyEntity) error {
-	return c.aboutCompanyRepo.EditByIDAboutCompany(ctx, req)
+func (c *aboutCompanyService) EditByIDAboutCompany(ctx context.Context, req entity.AboutCompanyEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyService.EditByIDAboutCompany")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeAboutCompany, func() error {
+		return trackRevision(ctx, c.revisionRepo, entity.ContentTypeAboutCompany, req.ID, func(ctx context.Context) error {
+			return c.aboutCompanyRepo.EditByIDAboutCompany(ctx, req)
+		})
+	})
 }
 
 // FetchAllAboutCompany implements AboutCompanyServiceInterface.
-func (c *aboutCompanyService) FetchAllAboutCompany(ctx context.Context) ([]entity.AboutCompanyEntity, error) {
+func (c *aboutCompanyService) FetchAllAboutCompany(ctx context.Context) (_ []entity.AboutCompanyEntity, err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyService.FetchAllAboutCompany")
+	defer tracing.End(span, &err)
+
 	return c.aboutCompanyRepo.FetchAllAboutCompany(ctx)
 }
 
 // FetchByIDAboutCompany implements AboutCompanyServiceInterface.
-func (c *aboutCompanyService) FetchByIDAboutCompany(ctx context.Context, id int64) (*entity.AboutCompanyEntity, error) {
+func (c *aboutCompanyService) FetchByIDAboutCompany(ctx context.Context, id int64) (_ *entity.AboutCompanyEntity, err error) {
+	ctx, span := tracing.Start(ctx, "AboutCompanyService.FetchByIDAboutCompany")
+	defer tracing.End(span, &err)
+
 	return c.aboutCompanyRepo.FetchByIDAboutCompany(ctx, id)
 }
 
-func NewAboutCompanyService(aboutCompanyRepo repository.AboutCompanyInterface) AboutCompanyServiceInterface {
+func NewAboutCompanyService(aboutCompanyRepo repository.AboutCompanyInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) AboutCompanyServiceInterface {
 	return &aboutCompanyService{
 		aboutCompanyRepo: aboutCompanyRepo,
+		revisionRepo:     revisionRepo,
+		cacheAdapter:     cacheAdapter,
 	}
 }
@@ -6,8 +6,9 @@ import (
 	"latihan-compro/internal/adapter/messaging"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type AppointmentServiceInterface interface {
@@ -22,34 +23,46 @@ type appointmentService struct {
 }
 
 // CreateAppointment implements AppointmentServiceInterface.
-func (c *appointmentService) CreateAppointment(ctx context.Context, req entity.AppointmentEntity) error {
+func (c *appointmentService) CreateAppointment(ctx context.Context, req entity.AppointmentEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "AppointmentService.CreateAppointment")
+	defer tracing.End(span, &err)
+
 	email, err := c.appointmentRepo.CreateAppointment(ctx, req)
 	if err != nil {
-		log.Errorf("[SERVICE] CreateAppointment - 1: %v", err)
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CreateAppointment - 1")
 		return err
 	}
 
 	body := fmt.Sprintf("You have received a new appointment request from %s", email)
-	err = c.sendEmail.SendEmailAppointment(nil, email, "New Appointment", body)
+	err = c.sendEmail.SendEmailAppointment(ctx, nil, email, "New Appointment", body)
 	if err != nil {
-		log.Errorf("[SERVICE] CreateAppointment - 2: %v", err)
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CreateAppointment - 2")
 		return err
 	}
 	return nil
 }
 
 // FetchAllAppointment implements AppointmentServiceInterface.
-func (c *appointmentService) FetchAllAppointment(ctx context.Context) ([]entity.AppointmentEntity, error) {
+func (c *appointmentService) FetchAllAppointment(ctx context.Context) (_ []entity.AppointmentEntity, err error) {
+	ctx, span := tracing.Start(ctx, "AppointmentService.FetchAllAppointment")
+	defer tracing.End(span, &err)
+
 	return c.appointmentRepo.FetchAllAppointment(ctx)
 }
 
 // FetchByIDAppointment implements AppointmentServiceInterface.
-func (c *appointmentService) FetchByIDAppointment(ctx context.Context, id int64) (*entity.AppointmentEntity, error) {
+func (c *appointmentService) FetchByIDAppointment(ctx context.Context, id int64) (_ *entity.AppointmentEntity, err error) {
+	ctx, span := tracing.Start(ctx, "AppointmentService.FetchByIDAppointment")
+	defer tracing.End(span, &err)
+
 	return c.appointmentRepo.FetchByIDAppointment(ctx, id)
 }
 
 // DeleteByIDAppointment implements AppointmentServiceInterface.
-func (c *appointmentService) DeleteByIDAppointment(ctx context.Context, id int64) error {
+func (c *appointmentService) DeleteByIDAppointment(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "AppointmentService.DeleteByIDAppointment")
+	defer tracing.End(span, &err)
+
 	return c.appointmentRepo.DeleteByIDAppointment(ctx, id)
 }
 func NewAppointmentService(appointmentRepo repository.AppointmentRepositoryInterface, sendEmail messaging.EmailMessagingInterface) AppointmentServiceInterface {
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const defaultAuditLogRetentionDays = 90
+
+type AuditLogServiceInterface interface {
+	CreateAuditLog(ctx context.Context, req entity.AuditLogEntity) error
+	FetchAllAuditLog(ctx context.Context, filter entity.AuditLogFilterEntity) ([]entity.AuditLogEntity, int64, error)
+	FetchContentSnapshot(ctx context.Context, contentType string, contentID int64) (*string, error)
+	PurgeExpiredAuditLog(ctx context.Context) error
+	StartRetention(ctx context.Context, interval time.Duration)
+}
+
+type auditLogService struct {
+	auditLogRepo repository.AuditLogRepositoryInterface
+	cfg          *config.Config
+}
+
+// CreateAuditLog implements AuditLogServiceInterface.
+func (a *auditLogService) CreateAuditLog(ctx context.Context, req entity.AuditLogEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "AuditLogService.CreateAuditLog")
+	defer tracing.End(span, &err)
+
+	return a.auditLogRepo.CreateAuditLog(ctx, req)
+}
+
+// FetchAllAuditLog implements AuditLogServiceInterface.
+func (a *auditLogService) FetchAllAuditLog(ctx context.Context, filter entity.AuditLogFilterEntity) (_ []entity.AuditLogEntity, _ int64, err error) {
+	ctx, span := tracing.Start(ctx, "AuditLogService.FetchAllAuditLog")
+	defer tracing.End(span, &err)
+
+	return a.auditLogRepo.FetchAllAuditLog(ctx, filter)
+}
+
+// FetchContentSnapshot implements AuditLogServiceInterface.
+func (a *auditLogService) FetchContentSnapshot(ctx context.Context, contentType string, contentID int64) (_ *string, err error) {
+	ctx, span := tracing.Start(ctx, "AuditLogService.FetchContentSnapshot")
+	defer tracing.End(span, &err)
+
+	return a.auditLogRepo.FetchContentSnapshot(ctx, contentType, contentID)
+}
+
+// PurgeExpiredAuditLog implements AuditLogServiceInterface.
+func (a *auditLogService) PurgeExpiredAuditLog(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "AuditLogService.PurgeExpiredAuditLog")
+	defer tracing.End(span, &err)
+
+	retentionDays := a.cfg.App.AuditLogRetentionDays
+	if retentionDays <= 0 {
+		retentionDays = defaultAuditLogRetentionDays
+	}
+
+	deleted, err := a.auditLogRepo.DeleteAuditLogBefore(ctx, time.Now().AddDate(0, 0, -retentionDays))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] PurgeExpiredAuditLog - 1")
+		return err
+	}
+
+	log.Ctx(ctx).Info().Msgf("[SERVICE] PurgeExpiredAuditLog: %d audit logs older than %d days deleted", deleted, retentionDays)
+	return nil
+}
+
+// StartRetention implements AuditLogServiceInterface.
+// It purges expired audit logs once at start and then every interval until ctx is done.
+func (a *auditLogService) StartRetention(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_ = a.PurgeExpiredAuditLog(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func NewAuditLogService(auditLogRepo repository.AuditLogRepositoryInterface, cfg *config.Config) AuditLogServiceInterface {
+	return &auditLogService{
+		auditLogRepo: auditLogRepo,
+		cfg:          cfg,
+	}
+}
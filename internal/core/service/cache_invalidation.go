@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"latihan-compro/internal/adapter/cache"
+
+	"github.com/rs/zerolog/log"
+)
+
+// invalidateCache runs mutate and, once it succeeded, drops the cached public
+// responses built from contentType. A failed invalidation is only logged, the
+// entries still expire with their ttl.
+func invalidateCache(ctx context.Context, cacheAdapter cache.CacheInterface, contentType string, mutate func() error) error {
+	if err := mutate(); err != nil {
+		return err
+	}
+
+	if err := cacheAdapter.Invalidate(ctx, contentType); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] invalidateCache - 1")
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/internal/adapter/storage"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/metrics"
+	"latihan-compro/utils/tracing"
+	"latihan-compro/utils/upload"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// chunkedUploadExpiry is how long an unfinished upload can be resumed.
+const chunkedUploadExpiry = 24 * time.Hour
+
+// sniffLength is how much of the first chunk is read to detect the file type.
+const sniffLength = 3072
+
+type ChunkedUploadServiceInterface interface {
+	CreateChunkedUpload(ctx context.Context, length int64, fileName string) (*entity.ChunkedUploadEntity, error)
+	FetchByIDChunkedUpload(ctx context.Context, id string) (*entity.ChunkedUploadEntity, error)
+	AppendChunkedUpload(ctx context.Context, id string, offset int64, body io.Reader, size int64) (*entity.ChunkedUploadEntity, error)
+	DeleteByIDChunkedUpload(ctx context.Context, id string) error
+	MaxSize() int64
+
+	PurgeExpiredChunkedUpload(ctx context.Context) error
+	StartRetention(ctx context.Context, interval time.Duration)
+}
+
+type chunkedUploadService struct {
+	chunkedUploadRepo repository.ChunkedUploadRepositoryInterface
+	mediaAssetRepo    repository.MediaAssetRepositoryInterface
+	storage           storage.StorageInterface
+	videoPolicy       *upload.Policy
+}
+
+// CreateChunkedUpload implements ChunkedUploadServiceInterface.
+func (c *chunkedUploadService) CreateChunkedUpload(ctx context.Context, length int64, fileName string) (_ *entity.ChunkedUploadEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ChunkedUploadService.CreateChunkedUpload")
+	defer tracing.End(span, &err)
+
+	if length <= 0 {
+		log.Ctx(ctx).Error().Err(conv.ErrBadParamInput).Msg("[SERVICE] CreateChunkedUpload - 1")
+		return nil, conv.ErrBadParamInput
+	}
+
+	if length > c.videoPolicy.MaxSize() {
+		err := fmt.Errorf("%w: videos may be at most %s", upload.ErrFileTooLarge, humanize.IBytes(uint64(c.videoPolicy.MaxSize())))
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CreateChunkedUpload - 2")
+		return nil, err
+	}
+
+	chunkedUpload := entity.ChunkedUploadEntity{
+		ID:         uuid.New().String(),
+		UploaderID: conv.GetUserIDByCtx(ctx),
+		FileName:   fileName,
+		Length:     length,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := c.chunkedUploadRepo.CreateChunkedUpload(ctx, chunkedUpload); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CreateChunkedUpload - 3")
+		return nil, err
+	}
+	return &chunkedUpload, nil
+}
+
+// FetchByIDChunkedUpload implements ChunkedUploadServiceInterface.
+func (c *chunkedUploadService) FetchByIDChunkedUpload(ctx context.Context, id string) (_ *entity.ChunkedUploadEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ChunkedUploadService.FetchByIDChunkedUpload")
+	defer tracing.End(span, &err)
+
+	return c.chunkedUploadRepo.FetchByIDChunkedUpload(ctx, id)
+}
+
+// AppendChunkedUpload implements ChunkedUploadServiceInterface.
+// The body is streamed to storage as its own chunk object. A request cut off
+// midway stores nothing, so the client resumes from the last accepted offset.
+// Once every byte arrived the chunks are joined into the final video. size is
+// the length of body, -1 when unknown, a body ending early stores nothing.
+func (c *chunkedUploadService) AppendChunkedUpload(ctx context.Context, id string, offset int64, body io.Reader, size int64) (_ *entity.ChunkedUploadEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ChunkedUploadService.AppendChunkedUpload")
+	defer tracing.End(span, &err)
+
+	chunkedUpload, err := c.chunkedUploadRepo.FetchByIDChunkedUpload(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] AppendChunkedUpload - 1")
+		return nil, err
+	}
+
+	if offset != chunkedUpload.Offset {
+		log.Ctx(ctx).Error().Err(conv.ErrUploadOffsetMismatch).Msg("[SERVICE] AppendChunkedUpload - 2")
+		return nil, conv.ErrUploadOffsetMismatch
+	}
+
+	if chunkedUpload.CompletedAt != nil {
+		return chunkedUpload, nil
+	}
+
+	if offset < chunkedUpload.Length {
+		chunk := entity.ChunkEntity{
+			Offset: offset,
+			Path:   fmt.Sprintf("%schunks/%s/%020d_%s", storage.PrivatePrefix, id, offset, uuid.New().String()),
+		}
+
+		remaining := chunkedUpload.Length - offset
+		if size > remaining {
+			size = remaining
+		}
+		reader := io.Reader(io.LimitReader(body, remaining))
+		if offset == 0 {
+			buffered := bufio.NewReaderSize(reader, sniffLength)
+			head, _ := buffered.Peek(sniffLength)
+			chunk.ContentType, chunk.Extension, err = c.videoPolicy.Detect(head, chunkedUpload.Length)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] AppendChunkedUpload - 3")
+				return nil, err
+			}
+			reader = buffered
+		}
+
+		counter := &countingReader{reader: reader}
+		if _, err = c.storage.UploadFile(ctx, chunk.Path, counter, size, "application/octet-stream"); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] AppendChunkedUpload - 4")
+			c.deleteFiles(chunk.Path)
+			return nil, err
+		}
+
+		if counter.size == 0 {
+			c.deleteFiles(chunk.Path)
+			return chunkedUpload, nil
+		}
+
+		chunk.Size = counter.size
+		if err = c.chunkedUploadRepo.AppendChunkChunkedUpload(ctx, id, chunk); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] AppendChunkedUpload - 5")
+			c.deleteFiles(chunk.Path)
+			return nil, err
+		}
+
+		chunkedUpload.Offset += chunk.Size
+		chunkedUpload.ChunkPaths = append(chunkedUpload.ChunkPaths, chunk.Path)
+		if chunk.ContentType != "" {
+			chunkedUpload.ContentType = chunk.ContentType
+			chunkedUpload.Extension = chunk.Extension
+		}
+	}
+
+	if chunkedUpload.Offset < chunkedUpload.Length {
+		return chunkedUpload, nil
+	}
+
+	// A failed assembly leaves the upload complete but unassembled, an empty
+	// request at the final offset retries it.
+	if err = c.assembleChunkedUpload(ctx, chunkedUpload); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] AppendChunkedUpload - 6")
+		return nil, err
+	}
+	return chunkedUpload, nil
+}
+
+// assembleChunkedUpload streams the chunks one after another into the final
+// object and records it in the media library. Only one chunk is read at a time.
+func (c *chunkedUploadService) assembleChunkedUpload(ctx context.Context, chunkedUpload *entity.ChunkedUploadEntity) error {
+	path := fmt.Sprintf("%s/videos/%s_%d%s", storage.PublicDir, uuid.New().String(), time.Now().Unix(), chunkedUpload.Extension)
+	reader := &chunkReader{storage: c.storage, paths: chunkedUpload.ChunkPaths}
+	defer reader.Close()
+
+	url, err := c.storage.UploadFile(ctx, path, reader, chunkedUpload.Length, chunkedUpload.ContentType)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] assembleChunkedUpload - 1")
+		return err
+	}
+
+	mediaAssetID, err := c.mediaAssetRepo.CreateMediaAsset(ctx, entity.MediaAssetEntity{
+		Path:         path,
+		Url:          url,
+		ContentType:  chunkedUpload.ContentType,
+		Size:         chunkedUpload.Length,
+		VariantPaths: []string{path},
+		UploaderID:   chunkedUpload.UploaderID,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] assembleChunkedUpload - 2")
+		c.deleteFiles(path)
+		return err
+	}
+	metrics.ObserveUpload(metrics.UploadChunked, chunkedUpload.Length)
+
+	if err = c.chunkedUploadRepo.CompleteChunkedUpload(ctx, chunkedUpload.ID, mediaAssetID); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] assembleChunkedUpload - 3")
+		return err
+	}
+
+	c.deleteFiles(chunkedUpload.ChunkPaths...)
+
+	now := time.Now()
+	chunkedUpload.MediaAssetID = mediaAssetID
+	chunkedUpload.Url = url
+	chunkedUpload.ChunkPaths = nil
+	chunkedUpload.CompletedAt = &now
+	return nil
+}
+
+// DeleteByIDChunkedUpload implements ChunkedUploadServiceInterface.
+// A completed upload keeps its video, which is managed in the media library.
+func (c *chunkedUploadService) DeleteByIDChunkedUpload(ctx context.Context, id string) (err error) {
+	ctx, span := tracing.Start(ctx, "ChunkedUploadService.DeleteByIDChunkedUpload")
+	defer tracing.End(span, &err)
+
+	chunkedUpload, err := c.chunkedUploadRepo.FetchByIDChunkedUpload(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DeleteByIDChunkedUpload - 1")
+		return err
+	}
+
+	if err = c.chunkedUploadRepo.DeleteByIDChunkedUpload(ctx, id); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DeleteByIDChunkedUpload - 2")
+		return err
+	}
+
+	c.deleteFiles(chunkedUpload.ChunkPaths...)
+	return nil
+}
+
+// MaxSize implements ChunkedUploadServiceInterface.
+func (c *chunkedUploadService) MaxSize() int64 {
+	return c.videoPolicy.MaxSize()
+}
+
+// PurgeExpiredChunkedUpload implements ChunkedUploadServiceInterface.
+func (c *chunkedUploadService) PurgeExpiredChunkedUpload(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "ChunkedUploadService.PurgeExpiredChunkedUpload")
+	defer tracing.End(span, &err)
+
+	results, err := c.chunkedUploadRepo.FetchExpiredChunkedUpload(ctx, time.Now().Add(-chunkedUploadExpiry))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] PurgeExpiredChunkedUpload - 1")
+		return err
+	}
+
+	for _, val := range results {
+		if err = c.chunkedUploadRepo.DeleteByIDChunkedUpload(ctx, val.ID); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] PurgeExpiredChunkedUpload - 2")
+			return err
+		}
+		c.deleteFiles(val.ChunkPaths...)
+	}
+	return nil
+}
+
+// StartRetention implements ChunkedUploadServiceInterface.
+// It purges expired uploads once at start and then every interval until ctx is done.
+func (c *chunkedUploadService) StartRetention(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_ = c.PurgeExpiredChunkedUpload(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deleteFiles removes stored objects that are no longer needed. Failures are
+// only logged, a leftover chunk never breaks an upload.
+func (c *chunkedUploadService) deleteFiles(paths ...string) {
+	for _, path := range paths {
+		if err := c.storage.DeleteFile(path); err != nil {
+			log.Error().Err(err).Msg("[SERVICE] deleteFiles - 1")
+		}
+	}
+}
+
+type countingReader struct {
+	reader io.Reader
+	size   int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// chunkReader reads stored chunks in order, opening each one only once the
+// previous one is exhausted.
+type chunkReader struct {
+	storage storage.StorageInterface
+	paths   []string
+	current io.ReadCloser
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.paths) == 0 {
+				return 0, io.EOF
+			}
+
+			file, err := r.storage.OpenFile(r.paths[0])
+			if err != nil {
+				return 0, err
+			}
+			r.current = file
+			r.paths = r.paths[1:]
+		}
+
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			r.current.Close()
+			r.current = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (r *chunkReader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+	return r.current.Close()
+}
+
+func NewChunkedUploadService(chunkedUploadRepo repository.ChunkedUploadRepositoryInterface, mediaAssetRepo repository.MediaAssetRepositoryInterface, storageAdapter storage.StorageInterface, cfg *config.Config) ChunkedUploadServiceInterface {
+	return &chunkedUploadService{
+		chunkedUploadRepo: chunkedUploadRepo,
+		mediaAssetRepo:    mediaAssetRepo,
+		storage:           storageAdapter,
+		videoPolicy:       upload.NewVideoPolicy(cfg),
+	}
+}
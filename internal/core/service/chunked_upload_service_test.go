@@ -0,0 +1,226 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/internal/core/domain/entity"
+	"strings"
+	"testing"
+	"time"
+)
+
+type memoryChunkedUploadRepository struct {
+	uploads map[string]entity.ChunkedUploadEntity
+}
+
+func (m *memoryChunkedUploadRepository) CreateChunkedUpload(ctx context.Context, req entity.ChunkedUploadEntity) error {
+	m.uploads[req.ID] = req
+	return nil
+}
+
+func (m *memoryChunkedUploadRepository) FetchByIDChunkedUpload(ctx context.Context, id string) (*entity.ChunkedUploadEntity, error) {
+	val, ok := m.uploads[id]
+	if !ok {
+		return nil, errors.New("chunked upload not found")
+	}
+	val.ChunkPaths = append([]string(nil), val.ChunkPaths...)
+	return &val, nil
+}
+
+func (m *memoryChunkedUploadRepository) AppendChunkChunkedUpload(ctx context.Context, id string, chunk entity.ChunkEntity) error {
+	val := m.uploads[id]
+	val.Offset += chunk.Size
+	val.ChunkPaths = append(val.ChunkPaths, chunk.Path)
+	if chunk.ContentType != "" {
+		val.ContentType = chunk.ContentType
+		val.Extension = chunk.Extension
+	}
+	m.uploads[id] = val
+	return nil
+}
+
+func (m *memoryChunkedUploadRepository) CompleteChunkedUpload(ctx context.Context, id string, mediaAssetID int64) error {
+	val := m.uploads[id]
+	now := time.Now()
+	val.CompletedAt = &now
+	val.MediaAssetID = mediaAssetID
+	val.ChunkPaths = nil
+	m.uploads[id] = val
+	return nil
+}
+
+func (m *memoryChunkedUploadRepository) DeleteByIDChunkedUpload(ctx context.Context, id string) error {
+	delete(m.uploads, id)
+	return nil
+}
+
+func (m *memoryChunkedUploadRepository) FetchExpiredChunkedUpload(ctx context.Context, before time.Time) ([]entity.ChunkedUploadEntity, error) {
+	return nil, nil
+}
+
+type memoryMediaAssetRepository struct {
+	repository.MediaAssetRepositoryInterface
+	assets []entity.MediaAssetEntity
+	err    error
+}
+
+func (m *memoryMediaAssetRepository) CreateMediaAsset(ctx context.Context, req entity.MediaAssetEntity) (int64, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+	m.assets = append(m.assets, req)
+	return int64(len(m.assets)), nil
+}
+
+// streamStorage keeps objects in memory. It reads uploads in small pieces and
+// records how they were sent, so tests see whether a caller streamed them.
+type streamStorage struct {
+	objects map[string][]byte
+	sizes   map[string]int64
+	open    int
+	maxOpen int
+	// failOn makes uploads to paths containing it fail.
+	failOn string
+	// beforeUpload runs as an upload starts, before its reader is read.
+	beforeUpload func(path string)
+}
+
+func newStreamStorage() *streamStorage {
+	return &streamStorage{objects: map[string][]byte{}, sizes: map[string]int64{}}
+}
+
+func (s *streamStorage) UploadFile(ctx context.Context, path string, file io.Reader, size int64, contentType string) (string, error) {
+	if s.beforeUpload != nil {
+		s.beforeUpload(path)
+	}
+	if s.failOn != "" && strings.Contains(path, s.failOn) {
+		return "", errors.New("storage is unavailable")
+	}
+
+	var buf bytes.Buffer
+	if _, err := io.CopyBuffer(&buf, file, make([]byte, 512)); err != nil {
+		return "", err
+	}
+	s.objects[path] = buf.Bytes()
+	s.sizes[path] = size
+	return "https://cdn.example.com/" + path, nil
+}
+
+func (s *streamStorage) DeleteFile(path string) error {
+	delete(s.objects, path)
+	return nil
+}
+
+func (s *streamStorage) OpenFile(path string) (io.ReadCloser, error) {
+	data, ok := s.objects[path]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	s.open++
+	s.maxOpen = max(s.maxOpen, s.open)
+	return &trackedFile{Reader: bytes.NewReader(data), storage: s}, nil
+}
+
+func (s *streamStorage) Ping(ctx context.Context) error {
+	return nil
+}
+
+type trackedFile struct {
+	*bytes.Reader
+	storage *streamStorage
+}
+
+func (f *trackedFile) Close() error {
+	f.storage.open--
+	return nil
+}
+
+// sourceReader counts how much of data was read.
+type sourceReader struct {
+	data []byte
+	read int
+}
+
+func (r *sourceReader) Read(p []byte) (int, error) {
+	if r.read == len(r.data) {
+		return 0, io.EOF
+	}
+	n := copy(p, r.data[r.read:])
+	r.read += n
+	return n, nil
+}
+
+func mp4Bytes(size int) []byte {
+	data := make([]byte, size)
+	copy(data, []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom"))
+	for i := 24; i < size; i++ {
+		data[i] = byte(i)
+	}
+	return data
+}
+
+func TestAppendChunkedUploadStreamsChunksAndAssembly(t *testing.T) {
+	chunkedUploadRepo := &memoryChunkedUploadRepository{uploads: map[string]entity.ChunkedUploadEntity{}}
+	mediaAssetRepo := &memoryMediaAssetRepository{}
+	storageAdapter := newStreamStorage()
+	svc := NewChunkedUploadService(chunkedUploadRepo, mediaAssetRepo, storageAdapter, &config.Config{})
+	ctx := context.Background()
+
+	video := mp4Bytes(100_000)
+	created, err := svc.CreateChunkedUpload(ctx, int64(len(video)), "intro.mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var body *sourceReader
+	storageAdapter.beforeUpload = func(path string) {
+		if strings.Contains(path, "/chunks/") && body.read == len(body.data) {
+			t.Errorf("chunk %s was read to its end before the upload started", path)
+		}
+	}
+
+	body = &sourceReader{data: video[:60_000]}
+	result, err := svc.AppendChunkedUpload(ctx, created.ID, 0, body, 60_000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Offset != 60_000 || result.CompletedAt != nil {
+		t.Fatalf("after the first chunk offset = %d, completed = %v", result.Offset, result.CompletedAt)
+	}
+
+	// Without a Content-Length the chunk is sent with an unknown size.
+	body = &sourceReader{data: video[60_000:]}
+	if result, err = svc.AppendChunkedUpload(ctx, created.ID, 60_000, body, -1); err != nil {
+		t.Fatal(err)
+	}
+	if result.CompletedAt == nil || len(mediaAssetRepo.assets) != 1 {
+		t.Fatalf("upload is not assembled: %+v", result)
+	}
+
+	asset := mediaAssetRepo.assets[0]
+	if !bytes.Equal(storageAdapter.objects[asset.Path], video) {
+		t.Errorf("assembled video has %d bytes, want the %d uploaded", len(storageAdapter.objects[asset.Path]), len(video))
+	}
+	if storageAdapter.sizes[asset.Path] != int64(len(video)) {
+		t.Errorf("assembly uploaded with size %d, want the known %d", storageAdapter.sizes[asset.Path], len(video))
+	}
+	chunkSizes := map[int64]bool{}
+	for path, size := range storageAdapter.sizes {
+		if strings.Contains(path, "/chunks/") {
+			chunkSizes[size] = true
+		}
+	}
+	if len(chunkSizes) != 2 || !chunkSizes[60_000] || !chunkSizes[-1] {
+		t.Errorf("chunks uploaded with sizes %v, want 60000 and -1", chunkSizes)
+	}
+	if storageAdapter.maxOpen != 1 {
+		t.Errorf("assembly held %d chunks open at once, want one at a time", storageAdapter.maxOpen)
+	}
+	if len(storageAdapter.objects) != 1 {
+		t.Errorf("storage keeps %d objects, want the chunks deleted once assembled", len(storageAdapter.objects))
+	}
+}
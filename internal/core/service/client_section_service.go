@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 )
 
 type ClientSectionServiceInterface interface {
@@ -15,34 +17,61 @@ type ClientSectionServiceInterface interface {
 }
 type clientSectionService struct {
 	clientSectionRepo repository.ClientSectionInterface
+	revisionRepo      repository.ContentRevisionRepositoryInterface
+	cacheAdapter      cache.CacheInterface
 }
 
 // CreateClientSection implements ClientSectionServiceInterface.
-func (c *clientSectionService) CreateClientSection(ctx context.Context, req entity.ClientSectionEntity) error {
-	return c.clientSectionRepo.CreateClientSection(ctx, req)
+func (c *clientSectionService) CreateClientSection(ctx context.Context, req entity.ClientSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientSectionService.CreateClientSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeClientSection, func() error {
+		return c.clientSectionRepo.CreateClientSection(ctx, req)
+	})
 }
 
 // FetchAllClientSection implements ClientSectionServiceInterface.
-func (c *clientSectionService) FetchAllClientSection(ctx context.Context) ([]entity.ClientSectionEntity, error) {
+func (c *clientSectionService) FetchAllClientSection(ctx context.Context) (_ []entity.ClientSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ClientSectionService.FetchAllClientSection")
+	defer tracing.End(span, &err)
+
 	return c.clientSectionRepo.FetchAllClientSection(ctx)
 }
 
 // FetchByIDClientSection implements ClientSectionServiceInterface.
-func (c *clientSectionService) FetchByIDClientSection(ctx context.Context, id int64) (*entity.ClientSectionEntity, error) {
+func (c *clientSectionService) FetchByIDClientSection(ctx context.Context, id int64) (_ *entity.ClientSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ClientSectionService.FetchByIDClientSection")
+	defer tracing.End(span, &err)
+
 	return c.clientSectionRepo.FetchByIDClientSection(ctx, id)
 }
 
 // EditByIDClientSection implements ClientSectionServiceInterface.
-func (c *clientSectionService) EditByIDClientSection(ctx context.Context, req entity.ClientSectionEntity) error {
-	return c.clientSectionRepo.EditByIDClientSection(ctx, req)
+func (c *clientSectionService) EditByIDClientSection(ctx context.Context, req entity.ClientSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientSectionService.EditByIDClientSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeClientSection, func() error {
+		return trackRevision(ctx, c.revisionRepo, entity.ContentTypeClientSection, req.ID, func(ctx context.Context) error {
+			return c.clientSectionRepo.EditByIDClientSection(ctx, req)
+		})
+	})
 }
 
 // DeleteByIDClientSection implements ClientSectionServiceInterface.
-func (c *clientSectionService) DeleteByIDClientSection(ctx context.Context, id int64) error {
-	return c.clientSectionRepo.DeleteByIDClientSection(ctx, id)
+func (c *clientSectionService) DeleteByIDClientSection(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientSectionService.DeleteByIDClientSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeClientSection, func() error {
+		return c.clientSectionRepo.DeleteByIDClientSection(ctx, id)
+	})
 }
-func NewClientSectionService(clientSectionRepo repository.ClientSectionInterface) ClientSectionServiceInterface {
+func NewClientSectionService(clientSectionRepo repository.ClientSectionInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) ClientSectionServiceInterface {
 	return &clientSectionService{
 		clientSectionRepo: clientSectionRepo,
+		revisionRepo:      revisionRepo,
+		cacheAdapter:      cacheAdapter,
 	}
 }
@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 )
 
 type ContactUsServiceInterface interface {
@@ -15,34 +17,61 @@ type ContactUsServiceInterface interface {
 }
 type contactUsService struct {
 	contactUsRepo repository.ContactUsInterface
+	revisionRepo  repository.ContentRevisionRepositoryInterface
+	cacheAdapter  cache.CacheInterface
 }
 
 // CreateContactUs implements ContactUsServiceInterface.
-func (c *contactUsService) CreateContactUs(ctx context.Context, req entity.ContactUsEntity) error {
-	return c.contactUsRepo.CreateContactUs(ctx, req)
+func (c *contactUsService) CreateContactUs(ctx context.Context, req entity.ContactUsEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "ContactUsService.CreateContactUs")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeContactUs, func() error {
+		return c.contactUsRepo.CreateContactUs(ctx, req)
+	})
 }
 
 // FetchAllContactUs implements ContactUsServiceInterface.
-func (c *contactUsService) FetchAllContactUs(ctx context.Context) ([]entity.ContactUsEntity, error) {
+func (c *contactUsService) FetchAllContactUs(ctx context.Context) (_ []entity.ContactUsEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ContactUsService.FetchAllContactUs")
+	defer tracing.End(span, &err)
+
 	return c.contactUsRepo.FetchAllContactUs(ctx)
 }
 
 // FetchByIDContactUs implements ContactUsServiceInterface.
-func (c *contactUsService) FetchByIDContactUs(ctx context.Context, id int64) (*entity.ContactUsEntity, error) {
+func (c *contactUsService) FetchByIDContactUs(ctx context.Context, id int64) (_ *entity.ContactUsEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ContactUsService.FetchByIDContactUs")
+	defer tracing.End(span, &err)
+
 	return c.contactUsRepo.FetchByIDContactUs(ctx, id)
 }
 
 // EditByIDContactUs implements ContactUsServiceInterface.
-func (c *contactUsService) EditByIDContactUs(ctx context.Context, req entity.ContactUsEntity) error {
-	return c.contactUsRepo.EditByIDContactUs(ctx, req)
+func (c *contactUsService) EditByIDContactUs(ctx context.Context, req entity.ContactUsEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "ContactUsService.EditByIDContactUs")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeContactUs, func() error {
+		return trackRevision(ctx, c.revisionRepo, entity.ContentTypeContactUs, req.ID, func(ctx context.Context) error {
+			return c.contactUsRepo.EditByIDContactUs(ctx, req)
+		})
+	})
 }
 
 // DeleteByIDContactUs implements ContactUsServiceInterface.
-func (c *contactUsService) DeleteByIDContactUs(ctx context.Context, id int64) error {
-	return c.contactUsRepo.DeleteByIDContactUs(ctx, id)
+func (c *contactUsService) DeleteByIDContactUs(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "ContactUsService.DeleteByIDContactUs")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeContactUs, func() error {
+		return c.contactUsRepo.DeleteByIDContactUs(ctx, id)
+	})
 }
-func NewContactUsService(contactUsRepo repository.ContactUsInterface) ContactUsServiceInterface {
+func NewContactUsService(contactUsRepo repository.ContactUsInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) ContactUsServiceInterface {
 	return &contactUsService{
 		contactUsRepo: contactUsRepo,
+		revisionRepo:  revisionRepo,
+		cacheAdapter:  cacheAdapter,
 	}
 }
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"latihan-compro/internal/adapter/cache"
+	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/tracing"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ContentPositionServiceInterface interface {
+	ReorderContent(ctx context.Context, contentType string, ids []int64) error
+}
+
+type contentPositionService struct {
+	positionRepo repository.ContentPositionRepositoryInterface
+	cacheAdapter cache.CacheInterface
+}
+
+// ReorderContent implements ContentPositionServiceInterface.
+func (c *contentPositionService) ReorderContent(ctx context.Context, contentType string, ids []int64) (err error) {
+	ctx, span := tracing.Start(ctx, "ContentPositionService.ReorderContent")
+	defer tracing.End(span, &err)
+
+	seen := map[int64]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			log.Ctx(ctx).Error().Msgf("[SERVICE] ReorderContent - 1: duplicate id %d", id)
+			return conv.ErrBadParamInput
+		}
+		seen[id] = true
+	}
+
+	return invalidateCache(ctx, c.cacheAdapter, contentType, func() error {
+		return c.positionRepo.ReorderContent(ctx, contentType, ids)
+	})
+}
+
+func NewContentPositionService(positionRepo repository.ContentPositionRepositoryInterface, cacheAdapter cache.CacheInterface) ContentPositionServiceInterface {
+	return &contentPositionService{
+		positionRepo: positionRepo,
+		cacheAdapter: cacheAdapter,
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"latihan-compro/internal/adapter/cache"
+	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/tracing"
+	"reflect"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm/schema"
+)
+
+// revisionIgnoredFields are bookkeeping columns that never show up in a diff.
+var revisionIgnoredFields = map[string]bool{
+	"ID":        true,
+	"CreatedAt": true,
+	"UpdatedAt": true,
+	"DeletedAt": true,
+	"Position":  true,
+}
+
+type ContentRevisionServiceInterface interface {
+	FetchAllRevision(ctx context.Context, contentType string, contentID int64) ([]entity.ContentRevisionEntity, error)
+	DiffRevision(ctx context.Context, contentType string, contentID, fromVersion, toVersion int64) ([]entity.ContentRevisionDiffEntity, error)
+	RestoreRevision(ctx context.Context, contentType string, contentID, version int64) error
+}
+
+type contentRevisionService struct {
+	revisionRepo repository.ContentRevisionRepositoryInterface
+	cacheAdapter cache.CacheInterface
+}
+
+// FetchAllRevision implements ContentRevisionServiceInterface.
+func (c *contentRevisionService) FetchAllRevision(ctx context.Context, contentType string, contentID int64) (_ []entity.ContentRevisionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ContentRevisionService.FetchAllRevision")
+	defer tracing.End(span, &err)
+
+	return c.revisionRepo.FetchAllRevision(ctx, contentType, contentID)
+}
+
+// DiffRevision implements ContentRevisionServiceInterface.
+func (c *contentRevisionService) DiffRevision(ctx context.Context, contentType string, contentID, fromVersion, toVersion int64) (_ []entity.ContentRevisionDiffEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ContentRevisionService.DiffRevision")
+	defer tracing.End(span, &err)
+
+	from, err := c.revisionRepo.FetchByVersionRevision(ctx, contentType, contentID, fromVersion)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DiffRevision - 1")
+		return nil, err
+	}
+
+	to, err := c.revisionRepo.FetchByVersionRevision(ctx, contentType, contentID, toVersion)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DiffRevision - 2")
+		return nil, err
+	}
+
+	fromFields := map[string]interface{}{}
+	if err = json.Unmarshal([]byte(from.Snapshot), &fromFields); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DiffRevision - 3")
+		return nil, err
+	}
+
+	toFields := map[string]interface{}{}
+	if err = json.Unmarshal([]byte(to.Snapshot), &toFields); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DiffRevision - 4")
+		return nil, err
+	}
+
+	names := []string{}
+	for name := range fromFields {
+		names = append(names, name)
+	}
+	for name := range toFields {
+		if _, ok := fromFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	naming := schema.NamingStrategy{}
+	diffs := []entity.ContentRevisionDiffEntity{}
+	for _, name := range names {
+		if revisionIgnoredFields[name] || reflect.DeepEqual(fromFields[name], toFields[name]) {
+			continue
+		}
+		diffs = append(diffs, entity.ContentRevisionDiffEntity{
+			Field: naming.ColumnName("", name),
+			From:  fromFields[name],
+			To:    toFields[name],
+		})
+	}
+
+	return diffs, nil
+}
+
+// RestoreRevision implements ContentRevisionServiceInterface.
+func (c *contentRevisionService) RestoreRevision(ctx context.Context, contentType string, contentID, version int64) (err error) {
+	ctx, span := tracing.Start(ctx, "ContentRevisionService.RestoreRevision")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, contentType, func() error {
+		return c.revisionRepo.RestoreRevision(ctx, entity.ContentRevisionEntity{
+			ContentType: contentType,
+			ContentID:   contentID,
+			Version:     version,
+			AuthorID:    conv.GetUserIDByCtx(ctx),
+		})
+	})
+}
+
+// trackRevision keeps the state of a content row before and after an edit,
+// edit must run its queries with the ctx it is given.
+func trackRevision(ctx context.Context, revisionRepo repository.ContentRevisionRepositoryInterface, contentType string, contentID int64, edit func(ctx context.Context) error) error {
+	return revisionRepo.TrackRevision(ctx, entity.ContentRevisionEntity{
+		ContentType: contentType,
+		ContentID:   contentID,
+		AuthorID:    conv.GetUserIDByCtx(ctx),
+	}, edit)
+}
+
+func NewContentRevisionService(revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) ContentRevisionServiceInterface {
+	return &contentRevisionService{
+		revisionRepo: revisionRepo,
+		cacheAdapter: cacheAdapter,
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/cache"
+	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/tracing"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ContentTranslationServiceInterface interface {
+	FetchAllTranslation(ctx context.Context, contentType string, contentID int64) ([]entity.ContentTranslationEntity, error)
+	UpsertTranslation(ctx context.Context, contentType string, contentID int64, locale string, fields map[string]string) error
+	DeleteTranslation(ctx context.Context, contentType string, contentID int64, locale string) error
+	FetchMissingTranslation(ctx context.Context, locale string) ([]entity.MissingTranslationEntity, error)
+}
+
+type contentTranslationService struct {
+	translationRepo repository.ContentTranslationRepositoryInterface
+	cacheAdapter    cache.CacheInterface
+	cfg             *config.Config
+}
+
+// FetchAllTranslation implements ContentTranslationServiceInterface.
+func (c *contentTranslationService) FetchAllTranslation(ctx context.Context, contentType string, contentID int64) (_ []entity.ContentTranslationEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ContentTranslationService.FetchAllTranslation")
+	defer tracing.End(span, &err)
+
+	if _, ok := entity.TranslatableFields[contentType]; !ok {
+		log.Ctx(ctx).Error().Msgf("[SERVICE] FetchAllTranslation - 1: %s is not translatable", contentType)
+		return nil, conv.ErrBadParamInput
+	}
+
+	return c.translationRepo.FetchAllTranslation(ctx, contentType, contentID)
+}
+
+// UpsertTranslation implements ContentTranslationServiceInterface.
+func (c *contentTranslationService) UpsertTranslation(ctx context.Context, contentType string, contentID int64, locale string, fields map[string]string) (err error) {
+	ctx, span := tracing.Start(ctx, "ContentTranslationService.UpsertTranslation")
+	defer tracing.End(span, &err)
+
+	if !c.isTranslationLocale(locale) {
+		log.Ctx(ctx).Error().Msgf("[SERVICE] UpsertTranslation - 1: unsupported locale %s", locale)
+		return conv.ErrBadParamInput
+	}
+
+	for field, value := range fields {
+		if !entity.IsTranslatableField(contentType, field) || strings.TrimSpace(value) == "" {
+			log.Ctx(ctx).Error().Msgf("[SERVICE] UpsertTranslation - 2: invalid field %s for %s", field, contentType)
+			return conv.ErrBadParamInput
+		}
+	}
+
+	return invalidateCache(ctx, c.cacheAdapter, contentType, func() error {
+		return c.translationRepo.UpsertTranslation(ctx, contentType, contentID, locale, fields)
+	})
+}
+
+// DeleteTranslation implements ContentTranslationServiceInterface.
+func (c *contentTranslationService) DeleteTranslation(ctx context.Context, contentType string, contentID int64, locale string) (err error) {
+	ctx, span := tracing.Start(ctx, "ContentTranslationService.DeleteTranslation")
+	defer tracing.End(span, &err)
+
+	if !c.isTranslationLocale(locale) {
+		log.Ctx(ctx).Error().Msgf("[SERVICE] DeleteTranslation - 1: unsupported locale %s", locale)
+		return conv.ErrBadParamInput
+	}
+
+	return invalidateCache(ctx, c.cacheAdapter, contentType, func() error {
+		return c.translationRepo.DeleteTranslation(ctx, contentType, contentID, locale)
+	})
+}
+
+// FetchMissingTranslation implements ContentTranslationServiceInterface.
+// An empty locale reports every supported locale but the default one.
+func (c *contentTranslationService) FetchMissingTranslation(ctx context.Context, locale string) (_ []entity.MissingTranslationEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ContentTranslationService.FetchMissingTranslation")
+	defer tracing.End(span, &err)
+
+	_, locales := c.cfg.App.Locales()
+	locales = locales[1:]
+
+	if locale != "" {
+		if !c.isTranslationLocale(locale) {
+			log.Ctx(ctx).Error().Msgf("[SERVICE] FetchMissingTranslation - 1: unsupported locale %s", locale)
+			return nil, conv.ErrBadParamInput
+		}
+		locales = []string{locale}
+	}
+
+	return c.translationRepo.FetchMissingTranslation(ctx, locales)
+}
+
+// isTranslationLocale reports whether locale is supported. The default locale
+// is stored on the content rows themselves, so it never takes translations.
+func (c *contentTranslationService) isTranslationLocale(locale string) bool {
+	_, locales := c.cfg.App.Locales()
+	for _, val := range locales[1:] {
+		if val == locale {
+			return true
+		}
+	}
+	return false
+}
+
+func NewContentTranslationService(translationRepo repository.ContentTranslationRepositoryInterface, cacheAdapter cache.CacheInterface, cfg *config.Config) ContentTranslationServiceInterface {
+	return &contentTranslationService{
+		translationRepo: translationRepo,
+		cacheAdapter:    cacheAdapter,
+		cfg:             cfg,
+	}
+}
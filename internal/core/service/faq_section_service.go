@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 )
 
 type FaqSectionServiceInterface interface {
@@ -16,35 +18,62 @@ type FaqSectionServiceInterface interface {
 
 type faqSectionService struct {
 	faqSectionRepo repository.FaqSectionRepositoryInterface
+	revisionRepo   repository.ContentRevisionRepositoryInterface
+	cacheAdapter   cache.CacheInterface
 }
 
 // CreateFaqSection implements FaqSectionServiceInterface.
-func (c *faqSectionService) CreateFaqSection(ctx context.Context, req entity.FaqSectionEntity) error {
-	return c.faqSectionRepo.CreateFaqSection(ctx, req)
+func (c *faqSectionService) CreateFaqSection(ctx context.Context, req entity.FaqSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "FaqSectionService.CreateFaqSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeFaqSection, func() error {
+		return c.faqSectionRepo.CreateFaqSection(ctx, req)
+	})
 }
 
 // DeleteByIDFaqSection implements FaqSectionServiceInterface.
-func (c *faqSectionService) DeleteByIDFaqSection(ctx context.Context, id int64) error {
-	return c.faqSectionRepo.DeleteByIDFaqSection(ctx, id)
+func (c *faqSectionService) DeleteByIDFaqSection(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "FaqSectionService.DeleteByIDFaqSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeFaqSection, func() error {
+		return c.faqSectionRepo.DeleteByIDFaqSection(ctx, id)
+	})
 }
 
 // EditByIDFaqSection implements FaqSectionServiceInterface.
-func (c *faqSectionService) EditByIDFaqSection(ctx context.Context, req entity.FaqSectionEntity) error {
-	return c.faqSectionRepo.EditByIDFaqSection(ctx, req)
+func (c *faqSectionService) EditByIDFaqSection(ctx context.Context, req entity.FaqSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "FaqSectionService.EditByIDFaqSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeFaqSection, func() error {
+		return trackRevision(ctx, c.revisionRepo, entity.ContentTypeFaqSection, req.ID, func(ctx context.Context) error {
+			return c.faqSectionRepo.EditByIDFaqSection(ctx, req)
+		})
+	})
 }
 
 // FetchAllFaqSection implements FaqSectionServiceInterface.
-func (c *faqSectionService) FetchAllFaqSection(ctx context.Context) ([]entity.FaqSectionEntity, error) {
+func (c *faqSectionService) FetchAllFaqSection(ctx context.Context) (_ []entity.FaqSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "FaqSectionService.FetchAllFaqSection")
+	defer tracing.End(span, &err)
+
 	return c.faqSectionRepo.FetchAllFaqSection(ctx)
 }
 
 // FetchByIDFaqSection implements FaqSectionServiceInterface.
-func (c *faqSectionService) FetchByIDFaqSection(ctx context.Context, id int64) (*entity.FaqSectionEntity, error) {
+func (c *faqSectionService) FetchByIDFaqSection(ctx context.Context, id int64) (_ *entity.FaqSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "FaqSectionService.FetchByIDFaqSection")
+	defer tracing.End(span, &err)
+
 	return c.faqSectionRepo.FetchByIDFaqSection(ctx, id)
 }
 
-func NewFaqSectionService(faqSectionRepo repository.FaqSectionRepositoryInterface) FaqSectionServiceInterface {
+func NewFaqSectionService(faqSectionRepo repository.FaqSectionRepositoryInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) FaqSectionServiceInterface {
 	return &faqSectionService{
 		faqSectionRepo: faqSectionRepo,
+		revisionRepo:   revisionRepo,
+		cacheAdapter:   cacheAdapter,
 	}
 }
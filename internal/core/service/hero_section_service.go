@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 )
 
 type HeroSectionServiceInterface interface {
@@ -16,35 +18,62 @@ type HeroSectionServiceInterface interface {
 
 type heroSectionService struct {
 	heroSectionRepo repository.HeroSectionInterface
+	revisionRepo    repository.ContentRevisionRepositoryInterface
+	cacheAdapter    cache.CacheInterface
 }
 
 // CreateHeroSection implements HeroSectionServiceInterface.
-func (h *heroSectionService) CreateHeroSection(ctx context.Context, req entity.HeroSectionEntity) error {
-	return h.heroSectionRepo.CreateHeroSection(ctx, req)
+func (h *heroSectionService) CreateHeroSection(ctx context.Context, req entity.HeroSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "HeroSectionService.CreateHeroSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, h.cacheAdapter, entity.ContentTypeHeroSection, func() error {
+		return h.heroSectionRepo.CreateHeroSection(ctx, req)
+	})
 }
 
 // FetchAllHeroSection implements HeroSectionServiceInterface.
-func (h *heroSectionService) FetchAllHeroSection(ctx context.Context) ([]entity.HeroSectionEntity, error) {
+func (h *heroSectionService) FetchAllHeroSection(ctx context.Context) (_ []entity.HeroSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "HeroSectionService.FetchAllHeroSection")
+	defer tracing.End(span, &err)
+
 	return h.heroSectionRepo.FetchAllHeroSection(ctx)
 }
 
 // FetchByIDHeroSection implements HeroSectionServiceInterface.
-func (h *heroSectionService) FetchByIDHeroSection(ctx context.Context, id int64) (*entity.HeroSectionEntity, error) {
+func (h *heroSectionService) FetchByIDHeroSection(ctx context.Context, id int64) (_ *entity.HeroSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "HeroSectionService.FetchByIDHeroSection")
+	defer tracing.End(span, &err)
+
 	return h.heroSectionRepo.FetchByIDHeroSection(ctx, id)
 }
 
 // EditByIDHeroSection implements HeroSectionServiceInterface.
-func (h *heroSectionService) EditByIDHeroSection(ctx context.Context, req entity.HeroSectionEntity) error {
-	return h.heroSectionRepo.EditByIDHeroSection(ctx, req)
+func (h *heroSectionService) EditByIDHeroSection(ctx context.Context, req entity.HeroSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "HeroSectionService.EditByIDHeroSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, h.cacheAdapter, entity.ContentTypeHeroSection, func() error {
+		return trackRevision(ctx, h.revisionRepo, entity.ContentTypeHeroSection, req.ID, func(ctx context.Context) error {
+			return h.heroSectionRepo.EditByIDHeroSection(ctx, req)
+		})
+	})
 }
 
 // DeleteByIDHeroSection implements HeroSectionServiceInterface.
-func (h *heroSectionService) DeleteByIDHeroSection(ctx context.Context, id int64) error {
-	return h.heroSectionRepo.DeleteByIDHeroSection(ctx, id)
+func (h *heroSectionService) DeleteByIDHeroSection(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "HeroSectionService.DeleteByIDHeroSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, h.cacheAdapter, entity.ContentTypeHeroSection, func() error {
+		return h.heroSectionRepo.DeleteByIDHeroSection(ctx, id)
+	})
 }
 
-func NewHeroSectionService(heroSectionRepo repository.HeroSectionInterface) HeroSectionServiceInterface {
+func NewHeroSectionService(heroSectionRepo repository.HeroSectionInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) HeroSectionServiceInterface {
 	return &heroSectionService{
 		heroSectionRepo: heroSectionRepo,
+		revisionRepo:    revisionRepo,
+		cacheAdapter:    cacheAdapter,
 	}
 }
@@ -0,0 +1,382 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/internal/adapter/storage"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/auth"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/metrics"
+	"latihan-compro/utils/tracing"
+	"latihan-compro/utils/upload"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+type MediaAssetServiceInterface interface {
+	UploadMediaAsset(ctx context.Context, file *upload.File, altText string) (*entity.MediaAssetEntity, error)
+	UploadDocumentMediaAsset(ctx context.Context, file *upload.File) (*entity.MediaAssetEntity, error)
+	PresignMediaAsset(ctx context.Context, contentType string, size int64) (*entity.PresignedUploadEntity, error)
+	CompletePresignedMediaAsset(ctx context.Context, req entity.CompletePresignedUploadEntity) (*entity.MediaAssetEntity, error)
+	FetchAllMediaAsset(ctx context.Context, filter entity.MediaAssetFilterEntity) ([]entity.MediaAssetEntity, int64, error)
+	FetchByIDMediaAsset(ctx context.Context, id int64) (*entity.MediaAssetEntity, error)
+	FetchMediaAssetReference(ctx context.Context, id int64) ([]entity.MediaAssetReferenceEntity, error)
+	EditAltTextMediaAsset(ctx context.Context, id int64, altText string) error
+	DeleteByIDMediaAsset(ctx context.Context, id int64) error
+	FetchOrphanMediaAsset(ctx context.Context) ([]entity.MediaAssetEntity, error)
+}
+
+// presignedUploadExpiry is how long a client has to upload a presigned file.
+const presignedUploadExpiry = 15 * time.Minute
+
+// presignedCompleteGrace is how long after the upload deadline a presigned
+// upload may still be completed, for an upload that finished right at it.
+const presignedCompleteGrace = 5 * time.Minute
+
+type mediaAssetService struct {
+	mediaAssetRepo repository.MediaAssetRepositoryInterface
+	storage        storage.StorageInterface
+	uploadPolicy   *upload.Policy
+	urlSigner      auth.UrlSignerInterface
+}
+
+// UploadMediaAsset implements MediaAssetServiceInterface.
+// Images going through the pipeline are recorded by their large JPEG variant,
+// the one stored in content path columns.
+func (m *mediaAssetService) UploadMediaAsset(ctx context.Context, file *upload.File, altText string) (_ *entity.MediaAssetEntity, err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.UploadMediaAsset")
+	defer tracing.End(span, &err)
+
+	basePath := fmt.Sprintf("%s/uploads/%s_%d", storage.PublicDir, uuid.New().String(), time.Now().Unix())
+	mediaAsset := entity.MediaAssetEntity{
+		UploaderID: conv.GetUserIDByCtx(ctx),
+		AltText:    altText,
+	}
+
+	if upload.IsProcessableImage(file.ContentType) {
+		variants, err := upload.ProcessImage(file, m.uploadPolicy.MaxImagePixels())
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] UploadMediaAsset - 1")
+			return nil, err
+		}
+
+		for _, variant := range variants {
+			path := upload.VariantPath(basePath, variant)
+			size := int64(variant.Data.Len())
+			url, err := m.storage.UploadFile(ctx, path, variant.Data, size, variant.ContentType)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] UploadMediaAsset - 2")
+				m.deleteFiles(mediaAsset.VariantPaths...)
+				return nil, err
+			}
+
+			mediaAsset.VariantPaths = append(mediaAsset.VariantPaths, path)
+			if variant.Size == upload.LargeVariant && variant.Format == upload.FormatJPEG {
+				mediaAsset.Path = path
+				mediaAsset.Url = url
+				mediaAsset.ContentType = variant.ContentType
+				mediaAsset.Size = size
+				mediaAsset.Width = variant.Width
+				mediaAsset.Height = variant.Height
+			}
+		}
+	} else {
+		if width, height, ok := upload.ImageSize(file); ok {
+			mediaAsset.Width = width
+			mediaAsset.Height = height
+		}
+		if _, err := file.Seek(0, 0); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] UploadMediaAsset - 3")
+			return nil, err
+		}
+
+		path := basePath + file.Extension
+		url, err := m.storage.UploadFile(ctx, path, file, file.Size, file.ContentType)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] UploadMediaAsset - 4")
+			return nil, err
+		}
+
+		mediaAsset.Path = path
+		mediaAsset.Url = url
+		mediaAsset.ContentType = file.ContentType
+		mediaAsset.Size = file.Size
+		mediaAsset.VariantPaths = []string{path}
+	}
+
+	id, err := m.mediaAssetRepo.CreateMediaAsset(ctx, mediaAsset)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] UploadMediaAsset - 5")
+		m.deleteFiles(mediaAsset.VariantPaths...)
+		return nil, err
+	}
+	metrics.ObserveUpload(metrics.UploadMedia, file.Size)
+
+	mediaAsset.ID = id
+	return &mediaAsset, nil
+}
+
+// UploadDocumentMediaAsset implements MediaAssetServiceInterface.
+// Documents are stored privately and only served through signed downloads.
+func (m *mediaAssetService) UploadDocumentMediaAsset(ctx context.Context, file *upload.File) (_ *entity.MediaAssetEntity, err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.UploadDocumentMediaAsset")
+	defer tracing.End(span, &err)
+
+	path := fmt.Sprintf("%sdocuments/%s_%d%s", storage.PrivatePrefix, uuid.New().String(), time.Now().Unix(), file.Extension)
+	url, err := m.storage.UploadFile(ctx, path, file, file.Size, file.ContentType)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] UploadDocumentMediaAsset - 1")
+		return nil, err
+	}
+
+	mediaAsset := entity.MediaAssetEntity{
+		Path:         path,
+		Url:          url,
+		ContentType:  file.ContentType,
+		Size:         file.Size,
+		VariantPaths: []string{path},
+		UploaderID:   conv.GetUserIDByCtx(ctx),
+	}
+
+	id, err := m.mediaAssetRepo.CreateMediaAsset(ctx, mediaAsset)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] UploadDocumentMediaAsset - 2")
+		m.deleteFiles(path)
+		return nil, err
+	}
+	metrics.ObserveUpload(metrics.UploadDocument, file.Size)
+
+	mediaAsset.ID = id
+	return &mediaAsset, nil
+}
+
+// PresignMediaAsset implements MediaAssetServiceInterface.
+// The server still names the file, the signature binds the upload to that
+// path so only it can be completed later.
+func (m *mediaAssetService) PresignMediaAsset(ctx context.Context, contentType string, size int64) (_ *entity.PresignedUploadEntity, err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.PresignMediaAsset")
+	defer tracing.End(span, &err)
+
+	presigner, ok := m.storage.(storage.PresignerInterface)
+	if !ok {
+		log.Ctx(ctx).Error().Err(conv.ErrPresignNotSupported).Msg("[SERVICE] PresignMediaAsset - 1")
+		return nil, conv.ErrPresignNotSupported
+	}
+
+	extension, err := m.uploadPolicy.Allow(contentType, size)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] PresignMediaAsset - 2")
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/uploads/%s_%d%s", storage.PublicDir, uuid.New().String(), time.Now().Unix(), extension)
+	presigned, err := presigner.PresignUpload(path, contentType, m.uploadPolicy.Limit(contentType), presignedUploadExpiry)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] PresignMediaAsset - 3")
+		return nil, err
+	}
+
+	expires, signature := m.urlSigner.Sign(path, presigned.ExpiresAt)
+	return &entity.PresignedUploadEntity{
+		Method:    presigned.Method,
+		Url:       presigned.Url,
+		Fields:    presigned.Fields,
+		Headers:   presigned.Headers,
+		Path:      path,
+		Expires:   expires,
+		Signature: signature,
+		ExpiresAt: presigned.ExpiresAt,
+	}, nil
+}
+
+// CompletePresignedMediaAsset implements MediaAssetServiceInterface.
+// The stored object is checked like any proxied upload, by its sniffed type
+// and actual size, and deleted when it does not pass.
+func (m *mediaAssetService) CompletePresignedMediaAsset(ctx context.Context, req entity.CompletePresignedUploadEntity) (_ *entity.MediaAssetEntity, err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.CompletePresignedMediaAsset")
+	defer tracing.End(span, &err)
+
+	presigner, ok := m.storage.(storage.PresignerInterface)
+	if !ok {
+		log.Ctx(ctx).Error().Err(conv.ErrPresignNotSupported).Msg("[SERVICE] CompletePresignedMediaAsset - 1")
+		return nil, conv.ErrPresignNotSupported
+	}
+
+	// Storage enforces the upload deadline, an upload that finished right at
+	// it may still be completed within presignedCompleteGrace.
+	err = m.urlSigner.VerifyUrl(req.Path, req.Expires, req.Signature)
+	if errors.Is(err, conv.ErrExpiredSignature) {
+		// The signature is valid, so is expires.
+		expiresAt, _ := strconv.ParseInt(req.Expires, 10, 64)
+		if time.Since(time.Unix(expiresAt, 0)) <= presignedCompleteGrace {
+			err = nil
+		}
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CompletePresignedMediaAsset - 2")
+		return nil, err
+	}
+
+	url := presigner.PublicUrl(req.Path)
+	if mediaAsset, err := m.mediaAssetRepo.FetchByUrlMediaAsset(ctx, url); err == nil {
+		return mediaAsset, nil
+	}
+
+	info, err := presigner.StatFile(req.Path)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CompletePresignedMediaAsset - 3")
+		return nil, conv.ErrNotFound
+	}
+
+	file, err := m.storage.OpenFile(req.Path)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CompletePresignedMediaAsset - 4")
+		return nil, err
+	}
+	defer file.Close()
+
+	head := bufio.NewReaderSize(file, sniffLength)
+	sniffed, _ := head.Peek(sniffLength)
+	contentType, extension, err := m.uploadPolicy.Detect(sniffed, info.Size)
+	if err == nil && extension != filepath.Ext(req.Path) {
+		err = fmt.Errorf("%w: %s is not %s", upload.ErrTypeNotAllowed, contentType, filepath.Ext(req.Path))
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CompletePresignedMediaAsset - 5")
+		if deleteErr := m.storage.DeleteFile(req.Path); deleteErr != nil {
+			log.Ctx(ctx).Error().Err(deleteErr).Msg("[SERVICE] CompletePresignedMediaAsset - 6")
+		}
+		return nil, err
+	}
+
+	mediaAsset := entity.MediaAssetEntity{
+		Path:         req.Path,
+		Url:          url,
+		ContentType:  contentType,
+		Size:         info.Size,
+		VariantPaths: []string{req.Path},
+		UploaderID:   conv.GetUserIDByCtx(ctx),
+		AltText:      req.AltText,
+	}
+	if width, height, ok := upload.ImageSize(head); ok {
+		mediaAsset.Width = width
+		mediaAsset.Height = height
+	}
+
+	id, err := m.mediaAssetRepo.CreateMediaAsset(ctx, mediaAsset)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CompletePresignedMediaAsset - 7")
+		return nil, err
+	}
+	metrics.ObserveUpload(metrics.UploadPresigned, info.Size)
+
+	mediaAsset.ID = id
+	return &mediaAsset, nil
+}
+
+// FetchAllMediaAsset implements MediaAssetServiceInterface.
+func (m *mediaAssetService) FetchAllMediaAsset(ctx context.Context, filter entity.MediaAssetFilterEntity) (_ []entity.MediaAssetEntity, _ int64, err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.FetchAllMediaAsset")
+	defer tracing.End(span, &err)
+
+	return m.mediaAssetRepo.FetchAllMediaAsset(ctx, filter)
+}
+
+// FetchByIDMediaAsset implements MediaAssetServiceInterface.
+func (m *mediaAssetService) FetchByIDMediaAsset(ctx context.Context, id int64) (_ *entity.MediaAssetEntity, err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.FetchByIDMediaAsset")
+	defer tracing.End(span, &err)
+
+	return m.mediaAssetRepo.FetchByIDMediaAsset(ctx, id)
+}
+
+// FetchMediaAssetReference implements MediaAssetServiceInterface.
+func (m *mediaAssetService) FetchMediaAssetReference(ctx context.Context, id int64) (_ []entity.MediaAssetReferenceEntity, err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.FetchMediaAssetReference")
+	defer tracing.End(span, &err)
+
+	mediaAsset, err := m.mediaAssetRepo.FetchByIDMediaAsset(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] FetchMediaAssetReference - 1")
+		return nil, err
+	}
+
+	return m.mediaAssetRepo.FetchMediaAssetReference(ctx, mediaAsset.Url)
+}
+
+// EditAltTextMediaAsset implements MediaAssetServiceInterface.
+func (m *mediaAssetService) EditAltTextMediaAsset(ctx context.Context, id int64, altText string) (err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.EditAltTextMediaAsset")
+	defer tracing.End(span, &err)
+
+	return m.mediaAssetRepo.EditAltTextMediaAsset(ctx, id, altText)
+}
+
+// DeleteByIDMediaAsset implements MediaAssetServiceInterface.
+// Assets still referenced by any content, trashed content included, are kept.
+func (m *mediaAssetService) DeleteByIDMediaAsset(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.DeleteByIDMediaAsset")
+	defer tracing.End(span, &err)
+
+	mediaAsset, err := m.mediaAssetRepo.FetchByIDMediaAsset(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DeleteByIDMediaAsset - 1")
+		return err
+	}
+
+	references, err := m.mediaAssetRepo.FetchMediaAssetReference(ctx, mediaAsset.Url)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DeleteByIDMediaAsset - 2")
+		return err
+	}
+
+	if len(references) > 0 {
+		log.Ctx(ctx).Error().Err(conv.ErrMediaAssetInUse).Msg("[SERVICE] DeleteByIDMediaAsset - 3")
+		return conv.ErrMediaAssetInUse
+	}
+
+	for _, path := range mediaAsset.VariantPaths {
+		if err = m.storage.DeleteFile(path); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DeleteByIDMediaAsset - 4")
+			return err
+		}
+	}
+
+	return m.mediaAssetRepo.DeleteByIDMediaAsset(ctx, id)
+}
+
+// FetchOrphanMediaAsset implements MediaAssetServiceInterface.
+func (m *mediaAssetService) FetchOrphanMediaAsset(ctx context.Context) (_ []entity.MediaAssetEntity, err error) {
+	ctx, span := tracing.Start(ctx, "MediaAssetService.FetchOrphanMediaAsset")
+	defer tracing.End(span, &err)
+
+	return m.mediaAssetRepo.FetchOrphanMediaAsset(ctx)
+}
+
+// deleteFiles removes the files of an upload that failed. Failures are only
+// logged, the upload error is what the caller gets.
+func (m *mediaAssetService) deleteFiles(paths ...string) {
+	for _, path := range paths {
+		if err := m.storage.DeleteFile(path); err != nil {
+			log.Error().Err(err).Msg("[SERVICE] deleteFiles - 1")
+		}
+	}
+}
+
+func NewMediaAssetService(mediaAssetRepo repository.MediaAssetRepositoryInterface, storageAdapter storage.StorageInterface, cfg *config.Config) MediaAssetServiceInterface {
+	return &mediaAssetService{
+		mediaAssetRepo: mediaAssetRepo,
+		storage:        storageAdapter,
+		uploadPolicy:   upload.NewPolicy(cfg),
+		urlSigner:      auth.NewUrlSigner(cfg),
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"latihan-compro/config"
+	"latihan-compro/utils/upload"
+	"testing"
+)
+
+type memoryFile struct {
+	*bytes.Reader
+}
+
+func (m *memoryFile) Close() error {
+	return nil
+}
+
+func pngFile(t *testing.T) *upload.File {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 40, 30))); err != nil {
+		t.Fatal(err)
+	}
+	return &upload.File{
+		File:        &memoryFile{bytes.NewReader(buf.Bytes())},
+		ContentType: "image/png",
+		Extension:   ".png",
+		Size:        int64(buf.Len()),
+	}
+}
+
+func TestUploadMediaAssetDeletesFilesOnFailure(t *testing.T) {
+	storageAdapter := newStreamStorage()
+	mediaAssetRepo := &memoryMediaAssetRepository{}
+	svc := NewMediaAssetService(mediaAssetRepo, storageAdapter, &config.Config{})
+
+	storageAdapter.failOn = "_medium.webp"
+	if _, err := svc.UploadMediaAsset(context.Background(), pngFile(t), "Logo"); err == nil {
+		t.Fatal("variant upload failure was not returned")
+	}
+	if len(storageAdapter.sizes) == 0 || len(storageAdapter.objects) != 0 {
+		t.Errorf("storage keeps %d of %d uploaded variants after a variant failed", len(storageAdapter.objects), len(storageAdapter.sizes))
+	}
+
+	storageAdapter.failOn = ""
+	mediaAssetRepo.err = errors.New("database is unavailable")
+	if _, err := svc.UploadMediaAsset(context.Background(), pngFile(t), "Logo"); err == nil {
+		t.Fatal("create failure was not returned")
+	}
+	if len(storageAdapter.objects) != 0 {
+		t.Errorf("storage keeps %d variants after the media asset was not created", len(storageAdapter.objects))
+	}
+
+	if _, err := svc.UploadDocumentMediaAsset(context.Background(), pngFile(t)); err == nil {
+		t.Fatal("create failure was not returned")
+	}
+	if len(storageAdapter.objects) != 0 {
+		t.Errorf("storage keeps the document after the media asset was not created")
+	}
+}
@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 )
 
 type OurTeamServiceInterface interface {
@@ -15,35 +17,62 @@ type OurTeamServiceInterface interface {
 }
 
 type ourTeamService struct {
-	ourTeamRepo repository.OurTeamInterface
+	ourTeamRepo  repository.OurTeamInterface
+	revisionRepo repository.ContentRevisionRepositoryInterface
+	cacheAdapter cache.CacheInterface
 }
 
 // CreateOurTeam implements OurTeamServiceInterface.
-func (h *ourTeamService) CreateOurTeam(ctx context.Context, req entity.OurTeamEntity) error {
-	return h.ourTeamRepo.CreateOurTeam(ctx, req)
+func (h *ourTeamService) CreateOurTeam(ctx context.Context, req entity.OurTeamEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "OurTeamService.CreateOurTeam")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, h.cacheAdapter, entity.ContentTypeOurTeam, func() error {
+		return h.ourTeamRepo.CreateOurTeam(ctx, req)
+	})
 }
 
 // DeleteByIDOurTeam implements OurTeamServiceInterface.
-func (h *ourTeamService) DeleteByIDOurTeam(ctx context.Context, id int64) error {
-	return h.ourTeamRepo.DeleteByIDOurTeam(ctx, id)
+func (h *ourTeamService) DeleteByIDOurTeam(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "OurTeamService.DeleteByIDOurTeam")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, h.cacheAdapter, entity.ContentTypeOurTeam, func() error {
+		return h.ourTeamRepo.DeleteByIDOurTeam(ctx, id)
+	})
 }
 
 // EditByIDOurTeam implements OurTeamServiceInterface.
-func (h *ourTeamService) EditByIDOurTeam(ctx context.Context, req entity.OurTeamEntity) error {
-	return h.ourTeamRepo.EditByIDOurTeam(ctx, req)
+func (h *ourTeamService) EditByIDOurTeam(ctx context.Context, req entity.OurTeamEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "OurTeamService.EditByIDOurTeam")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, h.cacheAdapter, entity.ContentTypeOurTeam, func() error {
+		return trackRevision(ctx, h.revisionRepo, entity.ContentTypeOurTeam, req.ID, func(ctx context.Context) error {
+			return h.ourTeamRepo.EditByIDOurTeam(ctx, req)
+		})
+	})
 }
 
 // FetchAllOurTeam implements OurTeamServiceInterface.
-func (h *ourTeamService) FetchAllOurTeam(ctx context.Context) ([]entity.OurTeamEntity, error) {
+func (h *ourTeamService) FetchAllOurTeam(ctx context.Context) (_ []entity.OurTeamEntity, err error) {
+	ctx, span := tracing.Start(ctx, "OurTeamService.FetchAllOurTeam")
+	defer tracing.End(span, &err)
+
 	return h.ourTeamRepo.FetchAllOurTeam(ctx)
 }
 
 // FetchByIDOurTeam implements OurTeamServiceInterface.
-func (h *ourTeamService) FetchByIDOurTeam(ctx context.Context, id int64) (*entity.OurTeamEntity, error) {
+func (h *ourTeamService) FetchByIDOurTeam(ctx context.Context, id int64) (_ *entity.OurTeamEntity, err error) {
+	ctx, span := tracing.Start(ctx, "OurTeamService.FetchByIDOurTeam")
+	defer tracing.End(span, &err)
+
 	return h.ourTeamRepo.FetchByIDOurTeam(ctx, id)
 }
-func NewOurTeamService(ourTeamRepo repository.OurTeamInterface) OurTeamServiceInterface {
+func NewOurTeamService(ourTeamRepo repository.OurTeamInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) OurTeamServiceInterface {
 	return &ourTeamService{
-		ourTeamRepo: ourTeamRepo,
+		ourTeamRepo:  ourTeamRepo,
+		revisionRepo: revisionRepo,
+		cacheAdapter: cacheAdapter,
 	}
 }
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type PortofolioDetailServiceInterface interface {
@@ -20,50 +22,78 @@ type PortofolioDetailServiceInterface interface {
 type portofolioDetailService struct {
 	portofolioDetailRepo  repository.PortofolioDetailRepositoryInterface
 	portofolioSectionRepo repository.PortofolioSectionRepositoryInterface
+	revisionRepo          repository.ContentRevisionRepositoryInterface
+	cacheAdapter          cache.CacheInterface
 }
 
 // CreatePortofolioDetail implements PortofolioDetailServiceInterface.
-func (c *portofolioDetailService) CreatePortofolioDetail(ctx context.Context, req entity.PortofolioDetailEntity) error {
-	_, err = c.portofolioSectionRepo.FetchByIDPortofolioSection(ctx, req.PortofolioSection.ID)
-	if err != nil {
-		log.Errorf("[SERVICE] CreatePortofolioDetail - 1: %v", err)
+func (c *portofolioDetailService) CreatePortofolioDetail(ctx context.Context, req entity.PortofolioDetailEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioDetailService.CreatePortofolioDetail")
+	defer tracing.End(span, &err)
+
+	if _, err := c.portofolioSectionRepo.FetchByIDPortofolioSection(ctx, req.PortofolioSection.ID); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CreatePortofolioDetail - 1")
 		return err
 	}
-	return c.portofolioDetailRepo.CreatePortofolioDetail(ctx, req)
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypePortofolioDetail, func() error {
+		return c.portofolioDetailRepo.CreatePortofolioDetail(ctx, req)
+	})
 }
 
 // FetchAllPortofolioDetail implements PortofolioDetailServiceInterface.
-func (c *portofolioDetailService) FetchAllPortofolioDetail(ctx context.Context) ([]entity.PortofolioDetailEntity, error) {
+func (c *portofolioDetailService) FetchAllPortofolioDetail(ctx context.Context) (_ []entity.PortofolioDetailEntity, err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioDetailService.FetchAllPortofolioDetail")
+	defer tracing.End(span, &err)
+
 	return c.portofolioDetailRepo.FetchAllPortofolioDetail(ctx)
 }
 
 // FetchByIDPortofolioDetail implements PortofolioDetailServiceInterface.
-func (c *portofolioDetailService) FetchByIDPortofolioDetail(ctx context.Context, id int64) (*entity.PortofolioDetailEntity, error) {
+func (c *portofolioDetailService) FetchByIDPortofolioDetail(ctx context.Context, id int64) (_ *entity.PortofolioDetailEntity, err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioDetailService.FetchByIDPortofolioDetail")
+	defer tracing.End(span, &err)
+
 	return c.portofolioDetailRepo.FetchByIDPortofolioDetail(ctx, id)
 }
 
 // EditByIDPortofolioDetail implements PortofolioDetailServiceInterface.
-func (c *portofolioDetailService) EditByIDPortofolioDetail(ctx context.Context, req entity.PortofolioDetailEntity) error {
-	_, err = c.portofolioSectionRepo.FetchByIDPortofolioSection(ctx, req.PortofolioSection.ID)
-	if err != nil {
-		log.Errorf("[SERVICE] EditByIDPortofolioDetail - 1: %v", err)
+func (c *portofolioDetailService) EditByIDPortofolioDetail(ctx context.Context, req entity.PortofolioDetailEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioDetailService.EditByIDPortofolioDetail")
+	defer tracing.End(span, &err)
+
+	if _, err := c.portofolioSectionRepo.FetchByIDPortofolioSection(ctx, req.PortofolioSection.ID); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] EditByIDPortofolioDetail - 1")
 		return err
 	}
-	return c.portofolioDetailRepo.EditByIDPortofolioDetail(ctx, req)
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypePortofolioDetail, func() error {
+		return trackRevision(ctx, c.revisionRepo, entity.ContentTypePortofolioDetail, req.ID, func(ctx context.Context) error {
+			return c.portofolioDetailRepo.EditByIDPortofolioDetail(ctx, req)
+		})
+	})
 }
 
 // DeleteByIDPortofolioDetail implements PortofolioDetailServiceInterface.
-func (c *portofolioDetailService) DeleteByIDPortofolioDetail(ctx context.Context, id int64) error {
-	return c.portofolioDetailRepo.DeleteByIDPortofolioDetail(ctx, id)
+func (c *portofolioDetailService) DeleteByIDPortofolioDetail(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioDetailService.DeleteByIDPortofolioDetail")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypePortofolioDetail, func() error {
+		return c.portofolioDetailRepo.DeleteByIDPortofolioDetail(ctx, id)
+	})
 }
 
 // FetchDetailPotofolioByPortoID implements PortofolioDetailServiceInterface.
-func (c *portofolioDetailService) FetchDetailPotofolioByPortoID(ctx context.Context, portoID int64) (*entity.PortofolioDetailEntity, error) {
+func (c *portofolioDetailService) FetchDetailPotofolioByPortoID(ctx context.Context, portoID int64) (_ *entity.PortofolioDetailEntity, err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioDetailService.FetchDetailPotofolioByPortoID")
+	defer tracing.End(span, &err)
+
 	return c.portofolioDetailRepo.FetchDetailPotofolioByPortoID(ctx, portoID)
 }
-func NewPortofolioDetailService(portofolioDetailRepo repository.PortofolioDetailRepositoryInterface, portofolioSectionRepo repository.PortofolioSectionRepositoryInterface) PortofolioDetailServiceInterface {
+func NewPortofolioDetailService(portofolioDetailRepo repository.PortofolioDetailRepositoryInterface, portofolioSectionRepo repository.PortofolioSectionRepositoryInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) PortofolioDetailServiceInterface {
 	return &portofolioDetailService{
 		portofolioDetailRepo:  portofolioDetailRepo,
 		portofolioSectionRepo: portofolioSectionRepo,
+		revisionRepo:          revisionRepo,
+		cacheAdapter:          cacheAdapter,
 	}
 }
@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 )
 
 type PortofolioSectionServiceInterface interface {
@@ -16,34 +18,61 @@ type PortofolioSectionServiceInterface interface {
 
 type portofolioSectionService struct {
 	portofolioSectionRepo repository.PortofolioSectionRepositoryInterface
+	revisionRepo          repository.ContentRevisionRepositoryInterface
+	cacheAdapter          cache.CacheInterface
 }
 
 // CreatePortofolioSection implements PortofolioSectionServiceInterface.
-func (c *portofolioSectionService) CreatePortofolioSection(ctx context.Context, req entity.PortofolioSectionEntity) error {
-	return c.portofolioSectionRepo.CreatePortofolioSection(ctx, req)
+func (c *portofolioSectionService) CreatePortofolioSection(ctx context.Context, req entity.PortofolioSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioSectionService.CreatePortofolioSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypePortofolioSection, func() error {
+		return c.portofolioSectionRepo.CreatePortofolioSection(ctx, req)
+	})
 }
 
 // FetchAllPortofolioSection implements PortofolioSectionServiceInterface.
-func (c *portofolioSectionService) FetchAllPortofolioSection(ctx context.Context) ([]entity.PortofolioSectionEntity, error) {
+func (c *portofolioSectionService) FetchAllPortofolioSection(ctx context.Context) (_ []entity.PortofolioSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioSectionService.FetchAllPortofolioSection")
+	defer tracing.End(span, &err)
+
 	return c.portofolioSectionRepo.FetchAllPortofolioSection(ctx)
 }
 
 // FetchByIDPortofolioSection implements PortofolioSectionServiceInterface.
-func (c *portofolioSectionService) FetchByIDPortofolioSection(ctx context.Context, id int64) (*entity.PortofolioSectionEntity, error) {
+func (c *portofolioSectionService) FetchByIDPortofolioSection(ctx context.Context, id int64) (_ *entity.PortofolioSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioSectionService.FetchByIDPortofolioSection")
+	defer tracing.End(span, &err)
+
 	return c.portofolioSectionRepo.FetchByIDPortofolioSection(ctx, id)
 }
 
 // EditByIDPortofolioSection implements PortofolioSectionServiceInterface.
-func (c *portofolioSectionService) EditByIDPortofolioSection(ctx context.Context, req entity.PortofolioSectionEntity) error {
-	return c.portofolioSectionRepo.EditByIDPortofolioSection(ctx, req)
+func (c *portofolioSectionService) EditByIDPortofolioSection(ctx context.Context, req entity.PortofolioSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioSectionService.EditByIDPortofolioSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypePortofolioSection, func() error {
+		return trackRevision(ctx, c.revisionRepo, entity.ContentTypePortofolioSection, req.ID, func(ctx context.Context) error {
+			return c.portofolioSectionRepo.EditByIDPortofolioSection(ctx, req)
+		})
+	})
 }
 
 // DeleteByIDPortofolioSection implements PortofolioSectionServiceInterface.
-func (c *portofolioSectionService) DeleteByIDPortofolioSection(ctx context.Context, id int64) error {
-	return c.portofolioSectionRepo.DeleteByIDPortofolioSection(ctx, id)
+func (c *portofolioSectionService) DeleteByIDPortofolioSection(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioSectionService.DeleteByIDPortofolioSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypePortofolioSection, func() error {
+		return c.portofolioSectionRepo.DeleteByIDPortofolioSection(ctx, id)
+	})
 }
-func NewPortofolioSectionService(portofolioSectionRepo repository.PortofolioSectionRepositoryInterface) PortofolioSectionServiceInterface {
+func NewPortofolioSectionService(portofolioSectionRepo repository.PortofolioSectionRepositoryInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) PortofolioSectionServiceInterface {
 	return &portofolioSectionService{
 		portofolioSectionRepo: portofolioSectionRepo,
+		revisionRepo:          revisionRepo,
+		cacheAdapter:          cacheAdapter,
 	}
 }
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 
-	"github.com/labstack/gommon/log"
+	"github.com/rs/zerolog/log"
 )
 
 type PortofolioTestimonialServiceInterface interface {
@@ -18,45 +20,70 @@ type PortofolioTestimonialServiceInterface interface {
 type portofolioTestimonialService struct {
 	portofolioTestimonialRepo repository.PortofolioTestimonialRepositoryInterface
 	portofolioSectionRepo     repository.PortofolioSectionRepositoryInterface
+	revisionRepo              repository.ContentRevisionRepositoryInterface
+	cacheAdapter              cache.CacheInterface
 }
 
 // CreatePortofolioTestimonial implements PortofolioTestimonialServiceInterface.
-func (c *portofolioTestimonialService) CreatePortofolioTestimonial(ctx context.Context, req entity.PortofolioTestimonialEntity) error {
-	_, err = c.portofolioSectionRepo.FetchByIDPortofolioSection(ctx, req.PortofolioSection.ID)
-	if err != nil {
-		log.Errorf("[SERVICE] CreatePortofolioTestimonial - 1: %v", err)
+func (c *portofolioTestimonialService) CreatePortofolioTestimonial(ctx context.Context, req entity.PortofolioTestimonialEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioTestimonialService.CreatePortofolioTestimonial")
+	defer tracing.End(span, &err)
+
+	if _, err := c.portofolioSectionRepo.FetchByIDPortofolioSection(ctx, req.PortofolioSection.ID); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] CreatePortofolioTestimonial - 1")
 		return err
 	}
-	return c.portofolioTestimonialRepo.CreatePortofolioTestimonial(ctx, req)
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypePortofolioTestimonial, func() error {
+		return c.portofolioTestimonialRepo.CreatePortofolioTestimonial(ctx, req)
+	})
 }
 
 // FetchAllPortofolioTestimonial implements PortofolioTestimonialServiceInterface.
-func (c *portofolioTestimonialService) FetchAllPortofolioTestimonial(ctx context.Context) ([]entity.PortofolioTestimonialEntity, error) {
+func (c *portofolioTestimonialService) FetchAllPortofolioTestimonial(ctx context.Context) (_ []entity.PortofolioTestimonialEntity, err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioTestimonialService.FetchAllPortofolioTestimonial")
+	defer tracing.End(span, &err)
+
 	return c.portofolioTestimonialRepo.FetchAllPortofolioTestimonial(ctx)
 }
 
 // FetchByIDPortofolioTestimonial implements PortofolioTestimonialServiceInterface.
-func (c *portofolioTestimonialService) FetchByIDPortofolioTestimonial(ctx context.Context, id int64) (*entity.PortofolioTestimonialEntity, error) {
+func (c *portofolioTestimonialService) FetchByIDPortofolioTestimonial(ctx context.Context, id int64) (_ *entity.PortofolioTestimonialEntity, err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioTestimonialService.FetchByIDPortofolioTestimonial")
+	defer tracing.End(span, &err)
+
 	return c.portofolioTestimonialRepo.FetchByIDPortofolioTestimonial(ctx, id)
 }
 
 // EditByIDPortofolioTestimonial implements PortofolioTestimonialServiceInterface.
-func (c *portofolioTestimonialService) EditByIDPortofolioTestimonial(ctx context.Context, req entity.PortofolioTestimonialEntity) error {
-	_, err = c.portofolioSectionRepo.FetchByIDPortofolioSection(ctx, req.PortofolioSection.ID)
-	if err != nil {
-		log.Errorf("[SERVICE] EditByIDPortofolioTestimonial - 1: %v", err)
+func (c *portofolioTestimonialService) EditByIDPortofolioTestimonial(ctx context.Context, req entity.PortofolioTestimonialEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioTestimonialService.EditByIDPortofolioTestimonial")
+	defer tracing.End(span, &err)
+
+	if _, err := c.portofolioSectionRepo.FetchByIDPortofolioSection(ctx, req.PortofolioSection.ID); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] EditByIDPortofolioTestimonial - 1")
 		return err
 	}
-	return c.portofolioTestimonialRepo.EditByIDPortofolioTestimonial(ctx, req)
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypePortofolioTestimonial, func() error {
+		return trackRevision(ctx, c.revisionRepo, entity.ContentTypePortofolioTestimonial, req.ID, func(ctx context.Context) error {
+			return c.portofolioTestimonialRepo.EditByIDPortofolioTestimonial(ctx, req)
+		})
+	})
 }
 
 // DeleteByIDPortofolioTestimonial implements PortofolioTestimonialServiceInterface.
-func (c *portofolioTestimonialService) DeleteByIDPortofolioTestimonial(ctx context.Context, id int64) error {
-	return c.portofolioTestimonialRepo.DeleteByIDPortofolioTestimonial(ctx, id)
+func (c *portofolioTestimonialService) DeleteByIDPortofolioTestimonial(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "PortofolioTestimonialService.DeleteByIDPortofolioTestimonial")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypePortofolioTestimonial, func() error {
+		return c.portofolioTestimonialRepo.DeleteByIDPortofolioTestimonial(ctx, id)
+	})
 }
-func NewPortofolioTestimonialService(portofolioTestimonialRepo repository.PortofolioTestimonialRepositoryInterface, portofolioSectionRepo repository.PortofolioSectionRepositoryInterface) PortofolioTestimonialServiceInterface {
+func NewPortofolioTestimonialService(portofolioTestimonialRepo repository.PortofolioTestimonialRepositoryInterface, portofolioSectionRepo repository.PortofolioSectionRepositoryInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) PortofolioTestimonialServiceInterface {
 	return &portofolioTestimonialService{
 		portofolioTestimonialRepo: portofolioTestimonialRepo,
 		portofolioSectionRepo:     portofolioSectionRepo,
+		revisionRepo:              revisionRepo,
+		cacheAdapter:              cacheAdapter,
 	}
 }
@@ -2,8 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
 	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/internal/adapter/storage"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
+	"latihan-compro/utils/conv"
+	"latihan-compro/utils/tracing"
+	"strings"
+	"unicode"
+
+	"github.com/rs/zerolog/log"
 )
 
 type ServiceDetailServiceInterface interface {
@@ -14,43 +23,164 @@ type ServiceDetailServiceInterface interface {
 	DeleteByIDServiceDetail(ctx context.Context, id int64) error
 
 	GetByServiceIDDetail(ctx context.Context, serviceId int64) (*entity.ServiceDetailEntity, error)
+
+	DownloadServiceDetail(ctx context.Context, id int64, fileType string) (*entity.ServiceDetailFileEntity, error)
+	FetchDownloadServiceDetail(ctx context.Context, id int64) ([]entity.ServiceDetailDownloadEntity, error)
 }
 
 type serviceDetailService struct {
 	serviceDetailRepo repository.ServiceDetailRepositoryInterface
+	revisionRepo      repository.ContentRevisionRepositoryInterface
+	mediaAssetRepo    repository.MediaAssetRepositoryInterface
+	storage           storage.StorageInterface
 }
 
 // CreateServiceDetail implements ServiceDetailServiceInterface.
-func (c *serviceDetailService) CreateServiceDetail(ctx context.Context, req entity.ServiceDetailEntity) error {
+func (c *serviceDetailService) CreateServiceDetail(ctx context.Context, req entity.ServiceDetailEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "ServiceDetailService.CreateServiceDetail")
+	defer tracing.End(span, &err)
+
 	return c.serviceDetailRepo.CreateServiceDetail(ctx, req)
 }
 
 // FetchAllServiceDetail implements ServiceDetailServiceInterface.
-func (c *serviceDetailService) FetchAllServiceDetail(ctx context.Context) ([]entity.ServiceDetailEntity, error) {
+func (c *serviceDetailService) FetchAllServiceDetail(ctx context.Context) (_ []entity.ServiceDetailEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ServiceDetailService.FetchAllServiceDetail")
+	defer tracing.End(span, &err)
+
 	return c.serviceDetailRepo.FetchAllServiceDetail(ctx)
 }
 
 // FetchByIDServiceDetail implements ServiceDetailServiceInterface.
-func (c *serviceDetailService) FetchByIDServiceDetail(ctx context.Context, id int64) (*entity.ServiceDetailEntity, error) {
+func (c *serviceDetailService) FetchByIDServiceDetail(ctx context.Context, id int64) (_ *entity.ServiceDetailEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ServiceDetailService.FetchByIDServiceDetail")
+	defer tracing.End(span, &err)
+
 	return c.serviceDetailRepo.FetchByIDServiceDetail(ctx, id)
 }
 
 // EditByIDServiceDetail implements ServiceDetailServiceInterface.
-func (c *serviceDetailService) EditByIDServiceDetail(ctx context.Context, req entity.ServiceDetailEntity) error {
-	return c.serviceDetailRepo.EditByIDServiceDetail(ctx, req)
+func (c *serviceDetailService) EditByIDServiceDetail(ctx context.Context, req entity.ServiceDetailEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "ServiceDetailService.EditByIDServiceDetail")
+	defer tracing.End(span, &err)
+
+	return trackRevision(ctx, c.revisionRepo, entity.ContentTypeServiceDetail, req.ID, func(ctx context.Context) error {
+		return c.serviceDetailRepo.EditByIDServiceDetail(ctx, req)
+	})
 }
 
 // DeleteByIDServiceDetail implements ServiceDetailServiceInterface.
-func (c *serviceDetailService) DeleteByIDServiceDetail(ctx context.Context, id int64) error {
+func (c *serviceDetailService) DeleteByIDServiceDetail(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "ServiceDetailService.DeleteByIDServiceDetail")
+	defer tracing.End(span, &err)
+
 	return c.serviceDetailRepo.DeleteByIDServiceDetail(ctx, id)
 }
 
 // GetByServiceIDDetail implements ServiceDetailServiceInterface.
-func (c *serviceDetailService) GetByServiceIDDetail(ctx context.Context, serviceId int64) (*entity.ServiceDetailEntity, error) {
+func (c *serviceDetailService) GetByServiceIDDetail(ctx context.Context, serviceId int64) (_ *entity.ServiceDetailEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ServiceDetailService.GetByServiceIDDetail")
+	defer tracing.End(span, &err)
+
 	return c.serviceDetailRepo.GetByServiceIDDetail(ctx, serviceId)
 }
-func NewServiceDetailService(serviceDetailRepo repository.ServiceDetailRepositoryInterface) ServiceDetailServiceInterface {
+
+// DownloadServiceDetail implements ServiceDetailServiceInterface.
+// Every call counts as a download of the requested file.
+func (c *serviceDetailService) DownloadServiceDetail(ctx context.Context, id int64, fileType string) (_ *entity.ServiceDetailFileEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ServiceDetailService.DownloadServiceDetail")
+	defer tracing.End(span, &err)
+
+	serviceDetail, err := c.serviceDetailRepo.FetchByIDServiceDetail(ctx, id)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DownloadServiceDetail - 1")
+		return nil, err
+	}
+
+	var url *string
+	switch fileType {
+	case entity.ServiceDetailFilePdf:
+		url = serviceDetail.PathPdf
+	case entity.ServiceDetailFileDocx:
+		url = serviceDetail.PathDocx
+	default:
+		log.Ctx(ctx).Error().Err(conv.ErrBadParamInput).Msg("[SERVICE] DownloadServiceDetail - 2")
+		return nil, conv.ErrBadParamInput
+	}
+
+	if url == nil || *url == "" {
+		log.Ctx(ctx).Error().Err(conv.ErrNotFound).Msg("[SERVICE] DownloadServiceDetail - 3")
+		return nil, conv.ErrNotFound
+	}
+
+	file := entity.ServiceDetailFileEntity{
+		FileName: documentFileName(serviceDetail.Title, fileType),
+		Url:      *url,
+	}
+
+	mediaAsset, err := c.mediaAssetRepo.FetchByUrlMediaAsset(ctx, *url)
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DownloadServiceDetail - 4")
+		return nil, err
+	}
+
+	// Only stored documents are counted, a redirect elsewhere is no download.
+	if mediaAsset == nil {
+		return &file, nil
+	}
+
+	file.ContentType = mediaAsset.ContentType
+	file.Size = mediaAsset.Size
+	file.Reader, err = c.storage.OpenFile(mediaAsset.Path)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DownloadServiceDetail - 5")
+		return nil, err
+	}
+
+	if err = c.serviceDetailRepo.IncrementDownloadServiceDetail(ctx, id, fileType); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] DownloadServiceDetail - 6")
+		file.Reader.Close()
+		return nil, err
+	}
+
+	return &file, nil
+}
+
+// FetchDownloadServiceDetail implements ServiceDetailServiceInterface.
+func (c *serviceDetailService) FetchDownloadServiceDetail(ctx context.Context, id int64) (_ []entity.ServiceDetailDownloadEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ServiceDetailService.FetchDownloadServiceDetail")
+	defer tracing.End(span, &err)
+
+	if _, err := c.serviceDetailRepo.FetchByIDServiceDetail(ctx, id); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] FetchDownloadServiceDetail - 1")
+		return nil, err
+	}
+
+	return c.serviceDetailRepo.FetchDownloadServiceDetail(ctx, id)
+}
+
+// documentFileName names a download after the service detail title, keeping
+// only characters that are safe in a Content-Disposition header.
+func documentFileName(title, fileType string) string {
+	name := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToLower(r)
+		}
+		return '-'
+	}, title)
+
+	name = strings.Join(strings.FieldsFunc(name, func(r rune) bool { return r == '-' }), "-")
+	if name == "" {
+		name = "document"
+	}
+	return name + "." + fileType
+}
+
+func NewServiceDetailService(serviceDetailRepo repository.ServiceDetailRepositoryInterface, revisionRepo repository.ContentRevisionRepositoryInterface, mediaAssetRepo repository.MediaAssetRepositoryInterface, storageAdapter storage.StorageInterface) ServiceDetailServiceInterface {
 	return &serviceDetailService{
 		serviceDetailRepo: serviceDetailRepo,
+		revisionRepo:      revisionRepo,
+		mediaAssetRepo:    mediaAssetRepo,
+		storage:           storageAdapter,
 	}
 }
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"io"
+	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/conv"
+	"testing"
+)
+
+type countingServiceDetailRepository struct {
+	repository.ServiceDetailRepositoryInterface
+	serviceDetail entity.ServiceDetailEntity
+	downloads     int
+}
+
+func (c *countingServiceDetailRepository) FetchByIDServiceDetail(ctx context.Context, id int64) (*entity.ServiceDetailEntity, error) {
+	val := c.serviceDetail
+	return &val, nil
+}
+
+func (c *countingServiceDetailRepository) IncrementDownloadServiceDetail(ctx context.Context, id int64, fileType string) error {
+	c.downloads++
+	return nil
+}
+
+type urlMediaAssetRepository struct {
+	repository.MediaAssetRepositoryInterface
+	assets map[string]entity.MediaAssetEntity
+}
+
+func (u *urlMediaAssetRepository) FetchByUrlMediaAsset(ctx context.Context, url string) (*entity.MediaAssetEntity, error) {
+	val, ok := u.assets[url]
+	if !ok {
+		return nil, conv.ErrNotFound
+	}
+	return &val, nil
+}
+
+func TestDownloadServiceDetailCountsStoredDocuments(t *testing.T) {
+	pdf := "https://cdn.example.com/private/documents/brochure.pdf"
+	docx := "https://example.org/brochure.docx"
+	serviceDetailRepo := &countingServiceDetailRepository{
+		serviceDetail: entity.ServiceDetailEntity{ID: 7, Title: "Web development", PathPdf: &pdf, PathDocx: &docx},
+	}
+	mediaAssetRepo := &urlMediaAssetRepository{assets: map[string]entity.MediaAssetEntity{
+		pdf: {Path: "private/documents/brochure.pdf", Url: pdf, ContentType: "application/pdf"},
+	}}
+	storageAdapter := newStreamStorage()
+	storageAdapter.objects["private/documents/brochure.pdf"] = []byte("%PDF-1.7")
+	svc := NewServiceDetailService(serviceDetailRepo, nil, mediaAssetRepo, storageAdapter)
+
+	file, err := svc.DownloadServiceDetail(context.Background(), 7, entity.ServiceDetailFileDocx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Reader != nil || file.Url != docx || serviceDetailRepo.downloads != 0 {
+		t.Fatalf("external document = %+v after %d downloads, want an uncounted redirect", file, serviceDetailRepo.downloads)
+	}
+
+	if file, err = svc.DownloadServiceDetail(context.Background(), 7, entity.ServiceDetailFilePdf); err != nil {
+		t.Fatal(err)
+	}
+	defer file.Reader.Close()
+	if data, _ := io.ReadAll(file.Reader); string(data) != "%PDF-1.7" || serviceDetailRepo.downloads != 1 {
+		t.Errorf("stored document = %q after %d downloads, want it counted once", data, serviceDetailRepo.downloads)
+	}
+}
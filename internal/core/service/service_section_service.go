@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"latihan-compro/internal/adapter/cache"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
 )
 
 type ServiceSectionServiceInterface interface {
@@ -15,38 +17,63 @@ type ServiceSectionServiceInterface interface {
 }
 type serviceSectionService struct {
 	serviceSectionRepo repository.ServiceSectionRepositoryInterface
+	revisionRepo       repository.ContentRevisionRepositoryInterface
+	cacheAdapter       cache.CacheInterface
 }
 
 // CreateServiceSection implements ServiceSectionServiceInterface.
 
-func (c *serviceSectionService) CreateServiceSection(ctx context.Context, req entity.ServiceSectionEntity) error {
-	return c.serviceSectionRepo.CreateServiceSection(ctx, req)
+func (c *serviceSectionService) CreateServiceSection(ctx context.Context, req entity.ServiceSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "ServiceSectionService.CreateServiceSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeServiceSection, func() error {
+		return c.serviceSectionRepo.CreateServiceSection(ctx, req)
+	})
 }
 
 // FetchAllServiceSection implements ServiceSectionServiceInterface.
 
-func (c *serviceSectionService) FetchAllServiceSection(ctx context.Context) ([]entity.ServiceSectionEntity, error) {
+func (c *serviceSectionService) FetchAllServiceSection(ctx context.Context) (_ []entity.ServiceSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ServiceSectionService.FetchAllServiceSection")
+	defer tracing.End(span, &err)
+
 	return c.serviceSectionRepo.FetchAllServiceSection(ctx)
 }
 
 // FetchByIDServiceSection implements ServiceSectionServiceInterface.
 
-func (c *serviceSectionService) FetchByIDServiceSection(ctx context.Context, id int64) (*entity.ServiceSectionEntity, error) {
+func (c *serviceSectionService) FetchByIDServiceSection(ctx context.Context, id int64) (_ *entity.ServiceSectionEntity, err error) {
+	ctx, span := tracing.Start(ctx, "ServiceSectionService.FetchByIDServiceSection")
+	defer tracing.End(span, &err)
+
 	return c.serviceSectionRepo.FetchByIDServiceSection(ctx, id)
 }
 
 // EditByIDServiceSection implements ServiceSectionServiceInterface.
 
-func (c *serviceSectionService) EditByIDServiceSection(ctx context.Context, req entity.ServiceSectionEntity) error {
-	return c.serviceSectionRepo.EditByIDServiceSection(ctx, req)
+func (c *serviceSectionService) EditByIDServiceSection(ctx context.Context, req entity.ServiceSectionEntity) (err error) {
+	ctx, span := tracing.Start(ctx, "ServiceSectionService.EditByIDServiceSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeServiceSection, func() error {
+		return trackRevision(ctx, c.revisionRepo, entity.ContentTypeServiceSection, req.ID, func(ctx context.Context) error {
+			return c.serviceSectionRepo.EditByIDServiceSection(ctx, req)
+		})
+	})
 }
 
 // DeleteByIDServiceSection implements ServiceSectionServiceInterface.
 
-func (c *serviceSectionService) DeleteByIDServiceSection(ctx context.Context, id int64) error {
-	return c.serviceSectionRepo.DeleteByIDServiceSection(ctx, id)
+func (c *serviceSectionService) DeleteByIDServiceSection(ctx context.Context, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "ServiceSectionService.DeleteByIDServiceSection")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, c.cacheAdapter, entity.ContentTypeServiceSection, func() error {
+		return c.serviceSectionRepo.DeleteByIDServiceSection(ctx, id)
+	})
 }
 
-func NewServiceSectionService(repo repository.ServiceSectionRepositoryInterface) ServiceSectionServiceInterface {
-	return &serviceSectionService{serviceSectionRepo: repo}
+func NewServiceSectionService(repo repository.ServiceSectionRepositoryInterface, revisionRepo repository.ContentRevisionRepositoryInterface, cacheAdapter cache.CacheInterface) ServiceSectionServiceInterface {
+	return &serviceSectionService{serviceSectionRepo: repo, revisionRepo: revisionRepo, cacheAdapter: cacheAdapter}
 }
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+type SiteServiceInterface interface {
+	FetchSite(ctx context.Context, sections []string) (*entity.SiteEntity, error)
+}
+
+type siteService struct {
+	heroSectionService           HeroSectionServiceInterface
+	clientSectionService         ClientSectionServiceInterface
+	aboutCompanyService          AboutCompanyServiceInterface
+	serviceSectionService        ServiceSectionServiceInterface
+	portofolioSectionService     PortofolioSectionServiceInterface
+	portofolioTestimonialService PortofolioTestimonialServiceInterface
+	ourTeamService               OurTeamServiceInterface
+	faqSectionService            FaqSectionServiceInterface
+	contactUsService             ContactUsServiceInterface
+}
+
+// FetchSite implements SiteServiceInterface.
+// Sections load concurrently and independently, one failing only leaves
+// that section out, so the error is only returned when every section failed.
+func (s *siteService) FetchSite(ctx context.Context, sections []string) (_ *entity.SiteEntity, err error) {
+	ctx, span := tracing.Start(ctx, "SiteService.FetchSite")
+	defer tracing.End(span, &err)
+
+	var (
+		site = entity.SiteEntity{Errors: map[string]error{}}
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+
+	fetchers := s.sectionFetchers(&site)
+	for _, section := range sections {
+		fetch, ok := fetchers[section]
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fetch(ctx); err != nil {
+				log.Ctx(ctx).Error().Err(err).Str("section", section).Msg("[SERVICE] FetchSite - 1")
+				mu.Lock()
+				site.Errors[section] = err
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(site.Errors) > 0 && len(site.Errors) == len(sections) {
+		for _, err := range site.Errors {
+			return nil, err
+		}
+	}
+	return &site, nil
+}
+
+// sectionFetchers returns, per section, a loader writing into its own field
+// of site, so loaders never share state.
+func (s *siteService) sectionFetchers(site *entity.SiteEntity) map[string]func(ctx context.Context) error {
+	return map[string]func(ctx context.Context) error{
+		entity.SiteHeroSection: func(ctx context.Context) error {
+			results, err := s.heroSectionService.FetchAllHeroSection(ctx)
+			if err == nil && len(results) > 0 {
+				site.HeroSection = &results[0]
+			}
+			return err
+		},
+		entity.SiteClientSections: func(ctx context.Context) (err error) {
+			site.ClientSections, err = s.clientSectionService.FetchAllClientSection(ctx)
+			return err
+		},
+		entity.SiteAboutCompany: func(ctx context.Context) (err error) {
+			site.AboutCompany, err = s.aboutCompanyService.FetchAllCompanyAndKeynote(ctx)
+			return err
+		},
+		entity.SiteServiceSections: func(ctx context.Context) (err error) {
+			site.ServiceSections, err = s.serviceSectionService.FetchAllServiceSection(ctx)
+			return err
+		},
+		entity.SitePortofolioSections: func(ctx context.Context) (err error) {
+			site.PortofolioSections, err = s.portofolioSectionService.FetchAllPortofolioSection(ctx)
+			return err
+		},
+		entity.SitePortofolioTestimonials: func(ctx context.Context) (err error) {
+			site.PortofolioTestimonials, err = s.portofolioTestimonialService.FetchAllPortofolioTestimonial(ctx)
+			return err
+		},
+		entity.SiteOurTeams: func(ctx context.Context) (err error) {
+			site.OurTeams, err = s.ourTeamService.FetchAllOurTeam(ctx)
+			return err
+		},
+		entity.SiteFaqSections: func(ctx context.Context) (err error) {
+			site.FaqSections, err = s.faqSectionService.FetchAllFaqSection(ctx)
+			return err
+		},
+		entity.SiteContactUs: func(ctx context.Context) error {
+			results, err := s.contactUsService.FetchAllContactUs(ctx)
+			if err == nil && len(results) > 0 {
+				site.ContactUs = &results[0]
+			}
+			return err
+		},
+	}
+}
+
+func NewSiteService(
+	heroSectionService HeroSectionServiceInterface,
+	clientSectionService ClientSectionServiceInterface,
+	aboutCompanyService AboutCompanyServiceInterface,
+	serviceSectionService ServiceSectionServiceInterface,
+	portofolioSectionService PortofolioSectionServiceInterface,
+	portofolioTestimonialService PortofolioTestimonialServiceInterface,
+	ourTeamService OurTeamServiceInterface,
+	faqSectionService FaqSectionServiceInterface,
+	contactUsService ContactUsServiceInterface,
+) SiteServiceInterface {
+	return &siteService{
+		heroSectionService:           heroSectionService,
+		clientSectionService:         clientSectionService,
+		aboutCompanyService:          aboutCompanyService,
+		serviceSectionService:        serviceSectionService,
+		portofolioSectionService:     portofolioSectionService,
+		portofolioTestimonialService: portofolioTestimonialService,
+		ourTeamService:               ourTeamService,
+		faqSectionService:            faqSectionService,
+		contactUsService:             contactUsService,
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/cache"
+	"latihan-compro/internal/adapter/repository"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/tracing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const defaultTrashRetentionDays = 30
+
+type TrashServiceInterface interface {
+	FetchAllTrash(ctx context.Context, contentType string) ([]entity.TrashEntity, error)
+	RestoreTrash(ctx context.Context, contentType string, id int64) error
+	PurgeTrash(ctx context.Context, contentType string, id int64) error
+	PurgeExpiredTrash(ctx context.Context) error
+	StartRetention(ctx context.Context, interval time.Duration)
+}
+
+type trashService struct {
+	trashRepo    repository.TrashRepositoryInterface
+	cacheAdapter cache.CacheInterface
+	cfg          *config.Config
+}
+
+// FetchAllTrash implements TrashServiceInterface.
+func (t *trashService) FetchAllTrash(ctx context.Context, contentType string) (_ []entity.TrashEntity, err error) {
+	ctx, span := tracing.Start(ctx, "TrashService.FetchAllTrash")
+	defer tracing.End(span, &err)
+
+	return t.trashRepo.FetchAllTrash(ctx, contentType)
+}
+
+// RestoreTrash implements TrashServiceInterface.
+func (t *trashService) RestoreTrash(ctx context.Context, contentType string, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "TrashService.RestoreTrash")
+	defer tracing.End(span, &err)
+
+	return invalidateCache(ctx, t.cacheAdapter, contentType, func() error {
+		return t.trashRepo.RestoreTrash(ctx, contentType, id)
+	})
+}
+
+// PurgeTrash implements TrashServiceInterface.
+func (t *trashService) PurgeTrash(ctx context.Context, contentType string, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "TrashService.PurgeTrash")
+	defer tracing.End(span, &err)
+
+	return t.trashRepo.PurgeTrash(ctx, contentType, id)
+}
+
+// PurgeExpiredTrash implements TrashServiceInterface.
+func (t *trashService) PurgeExpiredTrash(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "TrashService.PurgeExpiredTrash")
+	defer tracing.End(span, &err)
+
+	retentionDays := t.cfg.App.TrashRetentionDays
+	if retentionDays <= 0 {
+		retentionDays = defaultTrashRetentionDays
+	}
+
+	purged, err := t.trashRepo.PurgeTrashBefore(ctx, time.Now().AddDate(0, 0, -retentionDays))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("[SERVICE] PurgeExpiredTrash - 1")
+		return err
+	}
+
+	log.Ctx(ctx).Info().Msgf("[SERVICE] PurgeExpiredTrash: %d records deleted more than %d days ago purged", purged, retentionDays)
+	return nil
+}
+
+// StartRetention implements TrashServiceInterface.
+// It purges expired trash once at start and then every interval until ctx is done.
+func (t *trashService) StartRetention(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_ = t.PurgeExpiredTrash(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func NewTrashService(trashRepo repository.TrashRepositoryInterface, cacheAdapter cache.CacheInterface, cfg *config.Config) TrashServiceInterface {
+	return &trashService{
+		trashRepo:    trashRepo,
+		cacheAdapter: cacheAdapter,
+		cfg:          cfg,
+	}
+}
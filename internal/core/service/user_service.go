@@ -6,17 +6,14 @@ import (
 	"latihan-compro/config"
 	"latihan-compro/internal/adapter/repository"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
 	"latihan-compro/utils/auth"
 	"latihan-compro/utils/conv"
+	"latihan-compro/utils/tracing"
 
 	"github.com/rs/zerolog/log"
 )
 
-var (
-	err  error
-	code string
-)
-
 type UserServiceInterface interface {
 	LoginAdmin(ctx context.Context, req entity.UserEntity) (string, error)
 }
@@ -28,19 +25,25 @@ type userService struct {
 }
 
 // LoginAdmin implements UserService.
-func (u *userService) LoginAdmin(ctx context.Context, req entity.UserEntity) (string, error) {
+func (u *userService) LoginAdmin(ctx context.Context, req entity.UserEntity) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.LoginAdmin")
+	defer tracing.End(span, &err)
+
 	user, err := u.userRepo.GetUserByEmail(ctx, req.Email)
 	if err != nil {
-		code = "[SERVICE] LoginAdmin - 1"
-		log.Err(err).Msg(code)
+		code := "[SERVICE] LoginAdmin - 1"
+		log.Ctx(ctx).Error().Err(err).Msg(code)
+		// An unknown email is reported like a wrong password.
+		if errors.Is(err, errs.ErrNotFound) {
+			return "", conv.ErrWrongEmailOrPassword
+		}
 		return "", err
 	}
 
 	if checkPass := conv.CheckPasswordHash(req.Password, user.Password); !checkPass {
-		code = "[SERVICE] LoginAdmin - 2"
-		err = errors.New("invalid password")
-		log.Err(err).Msg(code)
-		return "", err
+		code := "[SERVICE] LoginAdmin - 2"
+		log.Ctx(ctx).Error().Err(conv.ErrWrongEmailOrPassword).Msg(code)
+		return "", conv.ErrWrongEmailOrPassword
 	}
 
 	jwtData := &entity.JwtData{
@@ -48,8 +51,8 @@ func (u *userService) LoginAdmin(ctx context.Context, req entity.UserEntity) (st
 	}
 	token, _, err := u.jwtAuth.GenerateToken(jwtData)
 	if err != nil {
-		code = "[SERVICE] LoginAdmin - 3"
-		log.Err(err).Msg(code)
+		code := "[SERVICE] LoginAdmin - 3"
+		log.Ctx(ctx).Error().Err(err).Msg(code)
 		return "", err
 	}
 
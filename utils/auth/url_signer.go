@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"latihan-compro/config"
+	"latihan-compro/utils/conv"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultUrlTTL = 15 * time.Minute
+
+type UrlSignerInterface interface {
+	// SignUrl returns the path with an expiry and a signature over both.
+	SignUrl(path string) string
+	VerifyUrl(path, expires, signature string) error
+	// Sign returns the signature of the path valid until expiresAt, for
+	// callers passing the expiry and signature some other way than a url.
+	Sign(path string, expiresAt time.Time) (string, string)
+}
+
+type urlSigner struct {
+	signingKey string
+	ttl        time.Duration
+	baseUrl    string
+}
+
+// SignUrl implements UrlSignerInterface.
+func (u *urlSigner) SignUrl(path string) string {
+	expires, signature := u.Sign(path, time.Now().Add(u.ttl))
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", signature)
+	return u.baseUrl + path + "?" + query.Encode()
+}
+
+// Sign implements UrlSignerInterface.
+func (u *urlSigner) Sign(path string, expiresAt time.Time) (string, string) {
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	return expires, u.signature(path, expires)
+}
+
+// VerifyUrl implements UrlSignerInterface.
+func (u *urlSigner) VerifyUrl(path, expires, signature string) error {
+	if !hmac.Equal([]byte(signature), []byte(u.signature(path, expires))) {
+		return conv.ErrInvalidSignature
+	}
+
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return conv.ErrInvalidSignature
+	}
+
+	if time.Now().Unix() > expiresAt {
+		return conv.ErrExpiredSignature
+	}
+	return nil
+}
+
+func (u *urlSigner) signature(path, expires string) string {
+	mac := hmac.New(sha256.New, []byte(u.signingKey))
+	mac.Write([]byte(path + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func NewUrlSigner(cfg *config.Config) UrlSignerInterface {
+	signingKey := cfg.Download.SigningKey
+	if signingKey == "" {
+		signingKey = cfg.App.JwtSecretKey
+	}
+
+	ttl := cfg.Download.UrlTTL
+	if ttl <= 0 {
+		ttl = defaultUrlTTL
+	}
+
+	return &urlSigner{
+		signingKey: signingKey,
+		ttl:        ttl,
+		baseUrl:    strings.TrimRight(cfg.Download.BaseUrl, "/"),
+	}
+}
@@ -1,21 +1,33 @@
 package conv
 
-import "errors"
+import "latihan-compro/internal/core/domain/errs"
 
 type contextKey string
 
 const (
 	CtxUserAgent = contextKey("user-agent")
+	CtxUserID    = contextKey("user-id")
+	CtxLocale    = contextKey("locale")
+	CtxRequestID = contextKey("request-id")
 )
 
 const (
 	MessageSuccess = "Success!"
 )
 
+// The errors below carry an errs.Kind, SetHTTPStatusCode maps it to a status.
 var (
-	ErrInternalServerError  = errors.New("internal server error")
-	ErrNotFound             = errors.New("data not found")
-	ErrUserAlreadyExist     = errors.New("user already exist")
-	ErrBadParamInput        = errors.New("given param is not valid")
-	ErrWrongEmailOrPassword = errors.New("wrong email/password")
+	ErrInternalServerError  = errs.New(errs.KindInternal, "", "internal server error")
+	ErrNotFound             = errs.NotFound("", "data not found")
+	ErrUserAlreadyExist     = errs.Conflict("user_already_exist", "user already exist")
+	ErrUnauthorized         = errs.Unauthorized("", "unauthorized")
+	ErrBadParamInput        = errs.Validation("bad_param_input", "given param is not valid")
+	ErrWrongEmailOrPassword = errs.Unauthorized("wrong_email_or_password", "wrong email/password")
+	ErrMediaAssetInUse      = errs.Conflict("media_asset_in_use", "media asset is still in use")
+	ErrInvalidSignature     = errs.Forbidden("invalid_signature", "invalid signature")
+	ErrExpiredSignature     = errs.Forbidden("expired_signature", "signature has expired")
+	ErrUploadOffsetMismatch = errs.Conflict("upload_offset_mismatch", "upload offset does not match")
+	ErrPresignNotSupported  = errs.New(errs.KindNotImplemented, "presign_not_supported", "storage driver does not support direct uploads")
+	ErrReorderIncomplete    = errs.Validation("reorder_incomplete", "ids must list every item exactly once")
+	ErrContentHasChildren   = errs.Conflict("content_has_children", "content is still referenced by content that is not deleted")
 )
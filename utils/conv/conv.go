@@ -1,7 +1,9 @@
 package conv
 
 import (
+	"context"
 	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/domain/errs"
 	"net/http"
 	"strconv"
 
@@ -19,17 +21,29 @@ func CheckPasswordHash(password, hash string) bool {
 	return err == nil
 }
 
+// SetHTTPStatusCode maps the kind of err to its HTTP status, errors of no
+// known kind are internal server errors.
 func SetHTTPStatusCode(err error) int {
-	if err == nil {
+	switch errs.KindOf(err) {
+	case "":
 		return http.StatusOK
-	}
-	switch err.Error() {
-	case ErrInternalServerError.Error():
-		return http.StatusInternalServerError
-	case ErrNotFound.Error():
+	case errs.KindNotFound:
 		return http.StatusNotFound
-	case ErrWrongEmailOrPassword.Error():
+	case errs.KindConflict:
+		return http.StatusConflict
+	case errs.KindValidation:
 		return http.StatusBadRequest
+	case errs.KindUnauthorized:
+		return http.StatusUnauthorized
+	case errs.KindForbidden:
+		return http.StatusForbidden
+	case errs.KindRateLimited:
+		return http.StatusTooManyRequests
+	case errs.KindNotImplemented:
+		return http.StatusNotImplemented
+	case errs.KindTimeout:
+		// Queries cut short by the request deadline wrap the context error.
+		return http.StatusGatewayTimeout
 	default:
 		return http.StatusInternalServerError
 	}
@@ -41,6 +55,26 @@ func GetUserIDByContext(ctx echo.Context) int64 {
 	return int64(claims.UserID)
 }
 
+// GetUserIDByCtx returns the user stored in the request context by the
+// CheckToken middleware, or 0 for anonymous requests.
+func GetUserIDByCtx(ctx context.Context) int64 {
+	userID, _ := ctx.Value(CtxUserID).(int64)
+	return userID
+}
+
+// GetLocaleByCtx returns the locale negotiated by the Locale middleware, or an
+// empty string when the request uses the default locale.
+func GetLocaleByCtx(ctx context.Context) string {
+	locale, _ := ctx.Value(CtxLocale).(string)
+	return locale
+}
+
+// GetRequestIDByCtx returns the id the RequestID middleware gave the request.
+func GetRequestIDByCtx(ctx context.Context) string {
+	requestID, _ := ctx.Value(CtxRequestID).(string)
+	return requestID
+}
+
 func StringToInt64(s string) (int64, error) {
 	newData, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
@@ -0,0 +1,34 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"latihan-compro/database/migrations"
+
+	"gorm.io/gorm"
+)
+
+// Database pings the database.
+func Database(db *gorm.DB) Checker {
+	return NewChecker("database", func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	})
+}
+
+// Migrations fails while a migration of the build is not applied yet.
+func Migrations(migrator migrations.MigratorInterface) Checker {
+	return NewChecker("migrations", func(ctx context.Context) error {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("%d pending migrations, up to %s", len(pending), pending[len(pending)-1])
+		}
+		return nil
+	})
+}
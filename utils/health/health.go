@@ -0,0 +1,218 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	StatusUp       = "up"
+	StatusDown     = "down"
+	StatusDegraded = "degraded"
+)
+
+const (
+	defaultTimeout  = 2 * time.Second
+	defaultCacheTTL = time.Second
+)
+
+var ErrShuttingDown = errors.New("server is shutting down")
+
+// Checker checks one component the server depends on.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type checkerFunc struct {
+	name     string
+	check    func(ctx context.Context) error
+	optional bool
+}
+
+func (c *checkerFunc) Name() string                    { return c.name }
+func (c *checkerFunc) Check(ctx context.Context) error { return c.check(ctx) }
+
+// NewChecker returns a Checker named name running check.
+func NewChecker(name string, check func(ctx context.Context) error) Checker {
+	return &checkerFunc{name: name, check: check}
+}
+
+// Optional returns a Checker whose failure degrades readiness without failing
+// it, for components only a few routes need.
+func Optional(checker Checker) Checker {
+	return &checkerFunc{name: checker.Name(), check: checker.Check, optional: true}
+}
+
+func isOptional(checker Checker) bool {
+	val, ok := checker.(*checkerFunc)
+	return ok && val.optional
+}
+
+// Component is the result of one checker.
+type Component struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Optional  bool    `json:"optional,omitempty"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report is the result of a liveness or readiness check.
+type Report struct {
+	Status     string      `json:"status"`
+	Error      string      `json:"error,omitempty"`
+	Components []Component `json:"components,omitempty"`
+}
+
+// WithoutErrors returns a copy of r without the errors of its components,
+// they may name hosts or credentials clients must not see.
+func (r Report) WithoutErrors() Report {
+	if r.Components == nil {
+		return r
+	}
+	components := make([]Component, len(r.Components))
+	for i, val := range r.Components {
+		val.Error = ""
+		components[i] = val
+	}
+	r.Components = components
+	return r
+}
+
+// Healthy reports whether traffic may be sent to the server, degraded
+// components still take it.
+func (r Report) Healthy() bool {
+	return r.Status != StatusDown
+}
+
+type HealthInterface interface {
+	// Live reports the process is running, it checks no dependency so an
+	// outage of one never gets the server restarted.
+	Live(ctx context.Context) Report
+	// Ready runs every checker, concurrently and each within the timeout.
+	// The report is reused for the cache TTL so frequent probes don't load
+	// the dependencies.
+	Ready(ctx context.Context) Report
+	// Shutdown makes Ready fail from now on, so load balancers stop sending
+	// requests before the server stops accepting them.
+	Shutdown()
+}
+
+type health struct {
+	checkers     []Checker
+	timeout      time.Duration
+	cacheTTL     time.Duration
+	shuttingDown atomic.Bool
+
+	mu        sync.Mutex
+	report    Report
+	checkedAt time.Time
+}
+
+// Live implements HealthInterface.
+func (h *health) Live(ctx context.Context) Report {
+	return Report{Status: StatusUp}
+}
+
+// Ready implements HealthInterface.
+func (h *health) Ready(ctx context.Context) Report {
+	if h.shuttingDown.Load() {
+		return Report{Status: StatusDown, Error: ErrShuttingDown.Error()}
+	}
+
+	// Probes arriving during a check wait for it rather than starting another.
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.checkedAt.IsZero() && time.Since(h.checkedAt) < h.cacheTTL {
+		return h.report
+	}
+	// The report outlives the probe that ran it, a probe hanging up must not
+	// fail it.
+	ctx = context.WithoutCancel(ctx)
+
+	components := make([]Component, len(h.checkers))
+	var wg sync.WaitGroup
+	for i, checker := range h.checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			components[i] = h.run(ctx, checker)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Components: components}
+	for _, val := range components {
+		switch {
+		case val.Status == StatusUp:
+		case val.Optional:
+			if report.Status == StatusUp {
+				report.Status = StatusDegraded
+			}
+		default:
+			report.Status = StatusDown
+		}
+	}
+	h.report = report
+	h.checkedAt = time.Now()
+	return report
+}
+
+func (h *health) run(ctx context.Context, checker Checker) Component {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	start := time.Now()
+	// A checker ignoring ctx still fails once the timeout passes.
+	done := make(chan error, 1)
+	go func() {
+		done <- checker.Check(ctx)
+	}()
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	component := Component{
+		Name:      checker.Name(),
+		Status:    StatusUp,
+		Optional:  isOptional(checker),
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("component", component.Name).Msg("[HEALTH] Ready - 1")
+		component.Status = StatusDown
+		component.Error = err.Error()
+	}
+	return component
+}
+
+// Shutdown implements HealthInterface.
+func (h *health) Shutdown() {
+	h.shuttingDown.Store(true)
+}
+
+// NewHealth returns the checks of checkers, each given timeout to answer, a
+// default of two seconds when it is not positive. Readiness reports are
+// reused for cacheTTL, a second when it is not positive.
+func NewHealth(timeout, cacheTTL time.Duration, checkers ...Checker) HealthInterface {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	if cacheTTL <= 0 {
+		cacheTTL = defaultCacheTTL
+	}
+	return &health{
+		checkers: checkers,
+		timeout:  timeout,
+		cacheTTL: cacheTTL,
+	}
+}
@@ -0,0 +1,102 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func up(name string) Checker {
+	return NewChecker(name, func(ctx context.Context) error { return nil })
+}
+
+func down(name string) Checker {
+	return NewChecker(name, func(ctx context.Context) error { return errors.New(name + " is down") })
+}
+
+func TestReady(t *testing.T) {
+	hung := NewChecker("hung", func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	tests := []struct {
+		name     string
+		checkers []Checker
+		want     string
+	}{
+		{"all up", []Checker{up("database"), up("storage")}, StatusUp},
+		{"required down", []Checker{up("database"), down("storage")}, StatusDown},
+		{"optional down", []Checker{up("database"), Optional(down("smtp"))}, StatusDegraded},
+		{"optional and required down", []Checker{Optional(down("smtp")), down("database")}, StatusDown},
+		{"hung checker", []Checker{up("database"), hung}, StatusDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			report := NewHealth(50*time.Millisecond, 0, tt.checkers...).Ready(context.Background())
+			if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+				t.Errorf("Ready() took %s, the timeout is not enforced", elapsed)
+			}
+			if report.Status != tt.want {
+				t.Errorf("Ready() status = %s, want %s: %+v", report.Status, tt.want, report)
+			}
+			if len(report.Components) != len(tt.checkers) {
+				t.Fatalf("Ready() reports %d components, want %d", len(report.Components), len(tt.checkers))
+			}
+			for i, val := range report.Components {
+				if val.Name != tt.checkers[i].Name() {
+					t.Errorf("component %d is %s, want %s", i, val.Name, tt.checkers[i].Name())
+				}
+				if (val.Status == StatusDown) != (val.Error != "") {
+					t.Errorf("component %s is %s with error %q", val.Name, val.Status, val.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestReadyFailsOnceShuttingDown(t *testing.T) {
+	h := NewHealth(time.Second, 0, up("database"))
+	if report := h.Ready(context.Background()); !report.Healthy() {
+		t.Fatalf("Ready() = %+v before shutdown, want healthy", report)
+	}
+
+	h.Shutdown()
+	if report := h.Ready(context.Background()); report.Healthy() || report.Error != ErrShuttingDown.Error() {
+		t.Errorf("Ready() = %+v during shutdown, want failing", report)
+	}
+	if report := h.Live(context.Background()); !report.Healthy() {
+		t.Errorf("Live() = %+v during shutdown, want healthy", report)
+	}
+}
+
+func TestReadyReusesReport(t *testing.T) {
+	calls := 0
+	counted := NewChecker("database", func(ctx context.Context) error {
+		calls++
+		return errors.New("dial tcp 10.0.0.5:5432: connection refused")
+	})
+	h := NewHealth(time.Second, 50*time.Millisecond, counted)
+
+	first := h.Ready(context.Background())
+	h.Ready(context.Background())
+	if calls != 1 {
+		t.Fatalf("checker ran %d times within the cache TTL, want 1", calls)
+	}
+	time.Sleep(60 * time.Millisecond)
+	h.Ready(context.Background())
+	if calls != 2 {
+		t.Errorf("checker ran %d times after the cache TTL, want 2", calls)
+	}
+
+	redacted := first.WithoutErrors()
+	if redacted.Components[0].Error != "" || redacted.Components[0].Status != StatusDown {
+		t.Errorf("WithoutErrors() = %+v, want the status without the error", redacted.Components[0])
+	}
+	if first.Components[0].Error == "" {
+		t.Error("WithoutErrors() modified the report it was called on")
+	}
+}
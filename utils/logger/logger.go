@@ -0,0 +1,36 @@
+package logger
+
+import (
+	"io"
+	"latihan-compro/config"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	FormatJSON    = "json"
+	FormatConsole = "console"
+)
+
+// Init configures the global zerolog logger from cfg. log.Ctx falls back to it
+// for contexts without a request logger, such as background jobs.
+func Init(cfg *config.Config) {
+	level, err := zerolog.ParseLevel(strings.ToLower(strings.TrimSpace(cfg.Log.Level)))
+	if err != nil || level == zerolog.NoLevel {
+		level = zerolog.InfoLevel
+	}
+	zerolog.SetGlobalLevel(level)
+	zerolog.TimeFieldFormat = time.RFC3339Nano
+
+	var out io.Writer = os.Stdout
+	if strings.EqualFold(strings.TrimSpace(cfg.Log.Format), FormatConsole) {
+		out = zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339}
+	}
+
+	log.Logger = zerolog.New(out).With().Timestamp().Logger()
+	zerolog.DefaultContextLogger = &log.Logger
+}
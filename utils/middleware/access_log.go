@@ -0,0 +1,182 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"latihan-compro/config"
+	"latihan-compro/utils/conv"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxLoggedBody caps the bytes of a body added to an access log.
+	maxLoggedBody = 16 << 10
+	redacted      = "[REDACTED]"
+)
+
+// sensitiveKeys are redacted from logged bodies and query strings, a key is
+// sensitive when it contains one of them.
+var sensitiveKeys = []string{"password", "token", "secret", "authorization", "signature", "api_key", "apikey", "cookie"}
+
+// AccessLog logs every request once it is answered, at warn level for client
+// errors and error level for server errors. With cfg.Log.AccessBody json
+// request and response bodies are logged too, sensitive fields redacted.
+func AccessLog(cfg *config.Config) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			req := c.Request()
+
+			var reqBody []byte
+			if cfg.Log.AccessBody && isJSON(req.Header.Get(echo.HeaderContentType)) && req.Body != nil {
+				reqBody, _ = io.ReadAll(io.LimitReader(req.Body, maxLoggedBody))
+				req.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.MultiReader(bytes.NewReader(reqBody), req.Body), req.Body}
+			}
+
+			var recorder *bodyRecorder
+			if cfg.Log.AccessBody {
+				recorder = &bodyRecorder{ResponseWriter: c.Response().Writer}
+				c.Response().Writer = recorder
+			}
+
+			if err := next(c); err != nil {
+				// Render the error now so its status is logged.
+				c.Error(err)
+			}
+
+			res := c.Response()
+			var event *zerolog.Event
+			logger := log.Ctx(c.Request().Context())
+			switch {
+			case res.Status >= http.StatusInternalServerError:
+				event = logger.Error()
+			case res.Status >= http.StatusBadRequest:
+				event = logger.Warn()
+			default:
+				event = logger.Info()
+			}
+
+			event = event.
+				Str("method", req.Method).
+				Str("route", c.Path()).
+				Str("uri", redactURI(req.URL)).
+				Int("status", res.Status).
+				Dur("latency", time.Since(start)).
+				Str("bytes_in", req.Header.Get(echo.HeaderContentLength)).
+				Int64("bytes_out", res.Size).
+				Str("remote_ip", c.RealIP()).
+				Str("user_agent", req.UserAgent())
+			if userID := conv.GetUserIDByCtx(c.Request().Context()); userID != 0 {
+				event = event.Int64("user_id", userID)
+			}
+			if len(reqBody) > 0 {
+				event = event.RawJSON("request_body", redactBody(reqBody))
+			}
+			if recorder != nil && isJSON(res.Header().Get(echo.HeaderContentType)) && recorder.body.Len() > 0 {
+				event = event.RawJSON("response_body", redactBody(recorder.body.Bytes()))
+			}
+			event.Msg("[MIDDLEWARE] AccessLog")
+
+			return nil
+		}
+	}
+}
+
+func isJSON(contentType string) bool {
+	return strings.HasPrefix(contentType, echo.MIMEApplicationJSON)
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, val := range sensitiveKeys {
+		if strings.Contains(key, val) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURI returns the request uri with sensitive query values redacted.
+func redactURI(u *url.URL) string {
+	query := u.Query()
+	if len(query) == 0 {
+		return u.RequestURI()
+	}
+	for key := range query {
+		if isSensitive(key) {
+			query.Set(key, redacted)
+		}
+	}
+	return u.EscapedPath() + "?" + query.Encode()
+}
+
+// redactBody returns body as json with sensitive fields redacted, bodies that
+// are not valid json, cut at maxLoggedBody for instance, are logged as their
+// size only.
+func redactBody(body []byte) []byte {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		raw, _ := json.Marshal(map[string]int{"unparsed_bytes": len(body)})
+		return raw
+	}
+
+	raw, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return []byte("null")
+	}
+	return raw
+}
+
+func redactValue(value interface{}) interface{} {
+	switch val := value.(type) {
+	case map[string]interface{}:
+		for key, item := range val {
+			if isSensitive(key) {
+				val[key] = redacted
+				continue
+			}
+			val[key] = redactValue(item)
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redactValue(item)
+		}
+		return val
+	default:
+		return val
+	}
+}
+
+// bodyRecorder keeps the first maxLoggedBody bytes written to the response.
+type bodyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	if room := maxLoggedBody - r.body.Len(); room > 0 {
+		if len(b) < room {
+			room = len(b)
+		}
+		r.body.Write(b[:room])
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *bodyRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
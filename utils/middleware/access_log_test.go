@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"latihan-compro/config"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func TestAccessLogRedactsAndCarriesRequestID(t *testing.T) {
+	var out bytes.Buffer
+	global := log.Logger
+	log.Logger = zerolog.New(&out)
+	zerolog.DefaultContextLogger = &log.Logger
+	t.Cleanup(func() {
+		log.Logger = global
+		zerolog.DefaultContextLogger = nil
+	})
+
+	cfg := &config.Config{}
+	cfg.Log.AccessBody = true
+
+	e := echo.New()
+	e.Use(RequestID())
+	e.Use(AccessLog(cfg))
+	e.POST("/login", func(c echo.Context) error {
+		body, _ := io.ReadAll(c.Request().Body)
+		if !strings.Contains(string(body), "hunter22") {
+			t.Errorf("handler read %s, want the original body", body)
+		}
+		log.Ctx(c.Request().Context()).Info().Msg("handler")
+		return c.JSON(http.StatusOK, map[string]interface{}{
+			"data": map[string]string{"token": "jwt-value", "name": "admin"},
+		})
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/login?signature=abc&page=2", strings.NewReader(`{"email":"admin@example.com","password":"hunter22"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderXRequestID, "req-123")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(echo.HeaderXRequestID); got != "req-123" {
+		t.Errorf("X-Request-ID = %q, want req-123", got)
+	}
+
+	logged := out.String()
+	for _, secret := range []string{"hunter22", "jwt-value", "abc"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("log leaks %q: %s", secret, logged)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(logged), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("logged %d lines, want the handler and access logs: %s", len(lines), logged)
+	}
+	for _, line := range lines {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("decoding %s: %v", line, err)
+		}
+		if entry["request_id"] != "req-123" {
+			t.Errorf("request_id of %s = %v, want req-123", line, entry["request_id"])
+		}
+	}
+
+	var access struct {
+		Status       int                    `json:"status"`
+		Uri          string                 `json:"uri"`
+		RequestBody  map[string]interface{} `json:"request_body"`
+		ResponseBody map[string]interface{} `json:"response_body"`
+	}
+	json.Unmarshal([]byte(lines[1]), &access)
+	if access.Status != http.StatusOK || access.RequestBody["email"] != "admin@example.com" || access.RequestBody["password"] != redacted {
+		t.Errorf("access log is %s", lines[1])
+	}
+	if !strings.Contains(access.Uri, "page=2") || !strings.Contains(access.Uri, "signature=%5BREDACTED%5D") {
+		t.Errorf("access log uri is %s", access.Uri)
+	}
+	if data, _ := access.ResponseBody["data"].(map[string]interface{}); data["token"] != redacted || data["name"] != "admin" {
+		t.Errorf("access log response body is %v", access.ResponseBody)
+	}
+}
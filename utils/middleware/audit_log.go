@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/internal/core/service"
+	"latihan-compro/utils/conv"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// auditSkippedPaths are mutating routes that do not change any data.
+var auditSkippedPaths = map[string]bool{
+	"/login": true,
+	// Every chunk of a video upload is a request, the finished video is
+	// tracked in the media library instead.
+	"/upload-video/:id": true,
+}
+
+// auditTables maps routes that change something other than a content model
+// to the table they write. Only content models have snapshots, so these are
+// audited with the request body alone; a translation is logged under the id
+// of the content it translates.
+var auditTables = map[string]string{
+	"media-assets":     "media_assets",
+	"upload-image":     "media_assets",
+	"upload-document":  "media_assets",
+	"upload-presigned": "media_assets",
+	"upload-video":     "chunked_uploads",
+	"translations":     "content_translations",
+}
+
+// auditSnapshotKey holds the hook CheckToken calls once the token is
+// verified, so nothing is read for a request carrying a forged token.
+const auditSnapshotKey = "audit_snapshot"
+
+// takeAuditSnapshot runs the before snapshot AuditLog registered on c.
+func takeAuditSnapshot(c echo.Context) {
+	if snapshot, ok := c.Get(auditSnapshotKey).(func()); ok {
+		snapshot()
+	}
+}
+
+// AuditLog records every successful create, update and delete request of an
+// authenticated user with the before/after state of the touched content.
+// Public routes such as POST /appointments are not audited, so customer data
+// never lands in the audit log.
+func AuditLog(auditLogService service.AuditLogServiceInterface) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			method := c.Request().Method
+			if method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions || auditSkippedPaths[c.Path()] ||
+				c.Request().Header.Get(echo.HeaderAuthorization) == "" {
+				return next(c)
+			}
+
+			// Generic routes such as /revisions/admin/:type/:id name the content in a param.
+			segment := strings.Split(strings.TrimPrefix(c.Path(), "/"), "/")[0]
+			entityType, snapshots := auditTables[segment], false
+			if entityType == "" {
+				entityType, snapshots = c.Param("type"), true
+				if entityType == "" {
+					entityType = segment
+				}
+			}
+			entityID, _ := conv.StringToInt64(c.Param("id"))
+
+			var before *string
+			if snapshots && entityID != 0 {
+				c.Set(auditSnapshotKey, func() {
+					ctx := c.Request().Context()
+					snapshot, err := auditLogService.FetchContentSnapshot(ctx, entityType, entityID)
+					if err != nil {
+						log.Ctx(ctx).Error().Err(err).Msg("[MIDDLEWARE] AuditLog - 1")
+					}
+					before = snapshot
+				})
+			}
+
+			var body []byte
+			if req := c.Request(); isJSON(req.Header.Get(echo.HeaderContentType)) && req.Body != nil {
+				body, _ = io.ReadAll(io.LimitReader(req.Body, maxLoggedBody))
+				req.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+			}
+
+			if err := next(c); err != nil {
+				return err
+			}
+
+			ctx := c.Request().Context()
+
+			// The token is checked by the route, a request it rejected or a
+			// public route it doesn't guard has no user.
+			claims, ok := c.Get("user").(*entity.JwtData)
+			if !ok || c.Response().Status >= http.StatusBadRequest {
+				return nil
+			}
+
+			auditLog := entity.AuditLogEntity{
+				EntityType:  entityType,
+				EntityID:    entityID,
+				BeforeValue: before,
+				IpAddress:   c.RealIP(),
+				UserAgent:   c.Request().UserAgent(),
+				ActorID:     int64(claims.UserID),
+			}
+
+			switch {
+			case method == http.MethodDelete:
+				auditLog.Action = entity.AuditActionDelete
+			case method == http.MethodPost && entityID == 0:
+				auditLog.Action = entity.AuditActionCreate
+				auditLog.AfterValue = redactedBody(body)
+			case !snapshots:
+				auditLog.Action = entity.AuditActionUpdate
+				auditLog.AfterValue = redactedBody(body)
+			default:
+				auditLog.Action = entity.AuditActionUpdate
+				snapshot, err := auditLogService.FetchContentSnapshot(ctx, entityType, entityID)
+				if err != nil {
+					log.Ctx(ctx).Error().Err(err).Msg("[MIDDLEWARE] AuditLog - 2")
+				}
+				auditLog.AfterValue = snapshot
+			}
+
+			if err := auditLogService.CreateAuditLog(ctx, auditLog); err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[MIDDLEWARE] AuditLog - 3")
+			}
+
+			return nil
+		}
+	}
+}
+
+// redactedBody returns the redacted request body, nil when there is none.
+func redactedBody(body []byte) *string {
+	if len(body) == 0 {
+		return nil
+	}
+	after := string(redactBody(body))
+	return &after
+}
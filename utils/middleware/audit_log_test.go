@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"latihan-compro/config"
+	"latihan-compro/internal/core/domain/entity"
+	"latihan-compro/utils/auth"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type fakeAuditLogService struct {
+	logs      []entity.AuditLogEntity
+	snapshots []string
+}
+
+func (f *fakeAuditLogService) CreateAuditLog(ctx context.Context, req entity.AuditLogEntity) error {
+	f.logs = append(f.logs, req)
+	return nil
+}
+
+func (f *fakeAuditLogService) FetchAllAuditLog(ctx context.Context, filter entity.AuditLogFilterEntity) ([]entity.AuditLogEntity, int64, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeAuditLogService) FetchContentSnapshot(ctx context.Context, contentType string, contentID int64) (*string, error) {
+	f.snapshots = append(f.snapshots, contentType)
+	snapshot := fmt.Sprintf(`{"id":%d,"snapshot":%d}`, contentID, len(f.snapshots))
+	return &snapshot, nil
+}
+
+func (f *fakeAuditLogService) PurgeExpiredAuditLog(ctx context.Context) error {
+	return nil
+}
+
+func (f *fakeAuditLogService) StartRetention(ctx context.Context, interval time.Duration) {}
+
+func TestAuditLogSkipsPublicRoutesAndRedacts(t *testing.T) {
+	auditLogService := &fakeAuditLogService{}
+
+	e := echo.New()
+	e.Use(AuditLog(auditLogService))
+	e.POST("/appointments", func(c echo.Context) error {
+		return c.NoContent(http.StatusCreated)
+	})
+	e.POST("/faq-sections", func(c echo.Context) error {
+		c.Set("user", &entity.JwtData{UserID: 7})
+		return c.NoContent(http.StatusCreated)
+	})
+
+	send := func(path, authorization string) {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"title":"Pricing","api_key":"abc123"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if authorization != "" {
+			req.Header.Set(echo.HeaderAuthorization, authorization)
+		}
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	send("/appointments", "")
+	send("/appointments", "Bearer forged")
+	if len(auditLogService.logs) != 0 {
+		t.Fatalf("public route was audited: %+v", auditLogService.logs)
+	}
+
+	send("/faq-sections", "Bearer token")
+	if len(auditLogService.logs) != 1 {
+		t.Fatalf("got %d audit logs, want 1", len(auditLogService.logs))
+	}
+	got := auditLogService.logs[0]
+	if got.ActorID != 7 || got.AfterValue == nil {
+		t.Fatalf("audit log = %+v", got)
+	}
+	if strings.Contains(*got.AfterValue, "abc123") || !strings.Contains(*got.AfterValue, "Pricing") {
+		t.Errorf("after value = %s, want api_key redacted", *got.AfterValue)
+	}
+}
+
+func TestAuditLogSnapshotsAfterTokenCheck(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.App.JwtSecretKey = "audit-log-test"
+	token, _, err := auth.NewJwt(cfg).GenerateToken(&entity.JwtData{UserID: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auditLogService := &fakeAuditLogService{}
+	e := echo.New()
+	e.Use(AuditLog(auditLogService))
+	checkToken := NewMiddleware(cfg).CheckToken()
+	ok := func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}
+	e.PUT("/faq-sections/admin/:id", ok, checkToken)
+	e.PUT("/translations/admin/:type/:id/:locale", ok, checkToken)
+
+	send := func(path, authorization string) {
+		req := httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"title":"Harga"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, authorization)
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	send("/faq-sections/admin/3", "Bearer forged")
+	if len(auditLogService.snapshots) != 0 || len(auditLogService.logs) != 0 {
+		t.Fatalf("forged token read %d snapshots and wrote %d audit logs", len(auditLogService.snapshots), len(auditLogService.logs))
+	}
+
+	send("/faq-sections/admin/3", "Bearer "+token)
+	if len(auditLogService.logs) != 1 {
+		t.Fatalf("got %d audit logs, want 1", len(auditLogService.logs))
+	}
+	got := auditLogService.logs[0]
+	if got.BeforeValue == nil || *got.BeforeValue != `{"id":3,"snapshot":1}` || got.AfterValue == nil || *got.AfterValue != `{"id":3,"snapshot":2}` {
+		t.Errorf("faq section audit log = %+v, want the before and after snapshots", got)
+	}
+
+	send("/translations/admin/faq-sections/3/en", "Bearer "+token)
+	if len(auditLogService.snapshots) != 2 {
+		t.Errorf("translation read snapshots of %v, want none", auditLogService.snapshots[2:])
+	}
+	got = auditLogService.logs[1]
+	if got.EntityType != "content_translations" || got.EntityID != 3 || got.BeforeValue != nil || got.AfterValue == nil || *got.AfterValue != `{"title":"Harga"}` {
+		t.Errorf("translation audit log = %+v, want the request body under content_translations", got)
+	}
+}
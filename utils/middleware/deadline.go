@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"latihan-compro/config"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultRequestTimeout = 30 * time.Second
+	defaultUploadTimeout  = 10 * time.Minute
+)
+
+// uploadPaths stream files and get the upload timeout.
+var uploadPaths = map[string]bool{
+	"/upload-image":                       true,
+	"/upload-document":                    true,
+	"/upload-video/:id":                   true,
+	"/service-details/:id/download/:type": true,
+}
+
+// Deadline bounds the context of every request, queries still running when it
+// passes are cancelled and the request fails with 504. Queries are cancelled
+// too when the client disconnects.
+func Deadline(cfg *config.Config) echo.MiddlewareFunc {
+	requestTimeout := cfg.App.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+	uploadTimeout := cfg.App.UploadTimeout
+	if uploadTimeout <= 0 {
+		uploadTimeout = defaultUploadTimeout
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			timeout := requestTimeout
+			if uploadPaths[c.Path()] {
+				timeout = uploadTimeout
+			}
+
+			ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
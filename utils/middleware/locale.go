@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"latihan-compro/utils/conv"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/text/language"
+)
+
+// Locale implements Middleware.
+// It negotiates the content locale from the lang query param, then from the
+// Accept-Language header, falling back to the default locale.
+func (o *Options) Locale() echo.MiddlewareFunc {
+	defaultLocale, locales := o.cfg.App.Locales()
+	matcher := newLocaleMatcher(locales)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			locale := locales[negotiateLocale(c, matcher)]
+
+			c.Response().Header().Set("Content-Language", locale)
+			c.Response().Header().Add(echo.HeaderVary, "Accept-Language")
+
+			if locale != defaultLocale {
+				ctx := context.WithValue(c.Request().Context(), conv.CtxLocale, locale)
+				c.SetRequest(c.Request().WithContext(ctx))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func newLocaleMatcher(locales []string) language.Matcher {
+	tags := make([]language.Tag, 0, len(locales))
+	for _, val := range locales {
+		tags = append(tags, language.Make(val))
+	}
+	return language.NewMatcher(tags)
+}
+
+// negotiateLocale returns the index of the matched locale.
+func negotiateLocale(c echo.Context, matcher language.Matcher) int {
+	if lang := c.QueryParam("lang"); lang != "" {
+		if tag, err := language.Parse(lang); err == nil {
+			if _, index, confidence := matcher.Match(tag); confidence != language.No {
+				return index
+			}
+		}
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(c.Request().Header.Get("Accept-Language"))
+	if err != nil || len(tags) == 0 {
+		return 0
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return 0
+	}
+	return index
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"latihan-compro/utils/metrics"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// unmatchedRoute labels requests no route matched, their paths would make the
+// route label unbounded.
+const unmatchedRoute = "unmatched"
+
+// Metrics records the count and latency of every request by method, route
+// pattern and status.
+func Metrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			if err := next(c); err != nil {
+				// Render the error now so its status is recorded.
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			metrics.ObserveHTTP(c.Request().Method, route, c.Response().Status, time.Since(start))
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"latihan-compro/utils/metrics"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestMetricsLabelsByRouteAndStatus(t *testing.T) {
+	e := echo.New()
+	e.Use(Metrics())
+	e.GET("/metrics-test/:id", func(c echo.Context) error {
+		if c.Param("id") == "missing" {
+			return echo.ErrNotFound
+		}
+		return c.String(http.StatusOK, "OK")
+	})
+
+	for _, path := range []string{"/metrics-test/1", "/metrics-test/2", "/metrics-test/missing", "/metrics-test-unknown"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+
+	for _, want := range []string{
+		`compro_http_requests_total{method="GET",route="/metrics-test/:id",status="200"} 2`,
+		`compro_http_requests_total{method="GET",route="/metrics-test/:id",status="404"} 1`,
+		`compro_http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+		`compro_http_request_duration_seconds_count{method="GET",route="/metrics-test/:id",status="200"} 2`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics miss %s", want)
+		}
+	}
+	if strings.Contains(body, "/metrics-test/1") {
+		t.Errorf("metrics are labelled by path instead of route")
+	}
+}
@@ -1,9 +1,11 @@
 package middleware
 
 import (
+	"context"
 	"latihan-compro/config"
 	"latihan-compro/internal/adapter/handler/response"
 	"latihan-compro/utils/auth"
+	"latihan-compro/utils/conv"
 	"net/http"
 	"strings"
 
@@ -12,10 +14,12 @@ import (
 
 type Middleware interface {
 	CheckToken() echo.MiddlewareFunc
+	Locale() echo.MiddlewareFunc
 }
 
 type Options struct {
 	authJwt auth.JwtInterface
+	cfg     *config.Config
 }
 
 // CheckToken implements Middleware.
@@ -53,6 +57,9 @@ func (o *Options) CheckToken() echo.MiddlewareFunc {
 
 			// Simpan claims ke context
 			c.Set("user", claims)
+			ctx := context.WithValue(c.Request().Context(), conv.CtxUserID, int64(claims.UserID))
+			c.SetRequest(c.Request().WithContext(ctx))
+			takeAuditSnapshot(c)
 
 			// Lanjutkan ke handler berikutnya
 			return next(c)
@@ -63,6 +70,7 @@ func (o *Options) CheckToken() echo.MiddlewareFunc {
 func NewMiddleware(cfg *config.Config) Middleware {
 	opt := new(Options)
 	opt.authJwt = auth.NewJwt(cfg)
+	opt.cfg = cfg
 
 	return opt
 }
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"latihan-compro/utils/conv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+const maxRequestIDLength = 128
+
+// RequestID gives every request an id, the X-Request-ID header sent by a proxy
+// or a new uuid. The id is echoed in the response and stored in the request
+// context with a logger carrying it, so log.Ctx(ctx) ties service and
+// repository logs to the request.
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			requestID := c.Request().Header.Get(echo.HeaderXRequestID)
+			if !validRequestID(requestID) {
+				requestID = uuid.NewString()
+			}
+			c.Response().Header().Set(echo.HeaderXRequestID, requestID)
+
+			ctx := context.WithValue(c.Request().Context(), conv.CtxRequestID, requestID)
+			logger := log.Ctx(ctx).With().Str("request_id", requestID).Logger()
+			c.SetRequest(c.Request().WithContext(logger.WithContext(ctx)))
+
+			return next(c)
+		}
+	}
+}
+
+// validRequestID accepts printable ascii ids of a sane length, anything else
+// could forge log lines.
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,182 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/cache"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultCacheMaxAge = time.Minute
+
+// cachedHeaders are the response headers stored along a cached body.
+var cachedHeaders = []string{echo.HeaderContentType, "Content-Language", echo.HeaderVary}
+
+// ResponseCache caches successful GET responses of the routes mapped to the
+// content types they are built from, and answers conditional requests with
+// 304. Entries are dropped when one of those content types is invalidated.
+// Responses the handler sent with Cache-Control: no-store are not cached.
+func ResponseCache(cacheAdapter cache.CacheInterface, cfg *config.Config, routes map[string][]string) echo.MiddlewareFunc {
+	_, locales := cfg.App.Locales()
+	matcher := newLocaleMatcher(locales)
+
+	maxAge := cfg.Cache.MaxAge
+	if maxAge <= 0 {
+		maxAge = defaultCacheMaxAge
+	}
+	cacheControl := "public, max-age=" + strconv.Itoa(int(maxAge.Seconds()))
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			tags, ok := routes[c.Path()]
+			if !ok || c.Request().Method != http.MethodGet {
+				return next(c)
+			}
+
+			ctx := c.Request().Context()
+
+			versions, err := cacheAdapter.Versions(ctx, tags...)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[MIDDLEWARE] ResponseCache - 1")
+				return next(c)
+			}
+			key := responseCacheKey(c, locales[negotiateLocale(c, matcher)], versions)
+
+			entry, err := cacheAdapter.Get(ctx, key)
+			if err == nil {
+				header := c.Response().Header()
+				for name, values := range entry.Header {
+					for _, val := range values {
+						if !slices.Contains(header.Values(name), val) {
+							header.Add(name, val)
+						}
+					}
+				}
+				header.Set("X-Cache", "HIT")
+				return writeCachedResponse(c, entry, cacheControl)
+			}
+			if !errors.Is(err, cache.ErrCacheMiss) {
+				log.Ctx(ctx).Error().Err(err).Msg("[MIDDLEWARE] ResponseCache - 2")
+			}
+
+			writer := c.Response().Writer
+			recorder := &responseRecorder{ResponseWriter: writer}
+			c.Response().Writer = recorder
+			err = next(c)
+			c.Response().Writer = writer
+
+			if recorder.status == 0 {
+				// The handler wrote nothing, its error is rendered upstream.
+				c.Response().Committed = false
+				return err
+			}
+			// Handlers mark responses that must not be reused, such as a
+			// partial /site, with Cache-Control: no-store.
+			if err != nil || recorder.status != http.StatusOK || noStore(c.Response().Header()) {
+				writer.WriteHeader(recorder.status)
+				_, _ = writer.Write(recorder.body.Bytes())
+				return err
+			}
+
+			sum := sha256.Sum256(recorder.body.Bytes())
+			entry = &cache.Entry{
+				Status:       recorder.status,
+				Header:       http.Header{},
+				Body:         recorder.body.Bytes(),
+				ETag:         `"` + hex.EncodeToString(sum[:16]) + `"`,
+				LastModified: time.Now().UTC().Truncate(time.Second),
+			}
+			for _, name := range cachedHeaders {
+				if values := c.Response().Header().Values(name); len(values) > 0 {
+					entry.Header[name] = values
+				}
+			}
+
+			if err = cacheAdapter.Set(ctx, key, entry); err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("[MIDDLEWARE] ResponseCache - 3")
+			}
+
+			c.Response().Header().Set("X-Cache", "MISS")
+			c.Response().Committed = false
+			return writeCachedResponse(c, entry, cacheControl)
+		}
+	}
+}
+
+// writeCachedResponse sends entry, or 304 when the client already holds it.
+func writeCachedResponse(c echo.Context, entry *cache.Entry, cacheControl string) error {
+	header := c.Response().Header()
+	header.Set(echo.HeaderLastModified, entry.LastModified.Format(http.TimeFormat))
+	header.Set("ETag", entry.ETag)
+	header.Set("Cache-Control", cacheControl)
+
+	if notModified(c.Request(), entry) {
+		header.Del(echo.HeaderContentType)
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.Blob(entry.Status, entry.Header.Get(echo.HeaderContentType), entry.Body)
+}
+
+func noStore(header http.Header) bool {
+	for _, val := range strings.Split(header.Get("Cache-Control"), ",") {
+		if strings.EqualFold(strings.TrimSpace(val), "no-store") {
+			return true
+		}
+	}
+	return false
+}
+
+// notModified follows RFC 9110, If-None-Match takes precedence over
+// If-Modified-Since.
+func notModified(req *http.Request, entry *cache.Entry) bool {
+	if match := req.Header.Get("If-None-Match"); match != "" {
+		for _, val := range strings.Split(match, ",") {
+			val = strings.TrimPrefix(strings.TrimSpace(val), "W/")
+			if val == "*" || val == entry.ETag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if since := req.Header.Get(echo.HeaderIfModifiedSince); since != "" {
+		if t, err := http.ParseTime(since); err == nil {
+			return !entry.LastModified.After(t)
+		}
+	}
+	return false
+}
+
+func responseCacheKey(c echo.Context, locale string, versions []int64) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s?%s|%s|%v", c.Request().URL.Path, c.QueryParams().Encode(), locale, versions))
+	return hex.EncodeToString(sum[:])
+}
+
+// responseRecorder holds the response back so it can be stored before being sent.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	r.status = code
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(b)
+}
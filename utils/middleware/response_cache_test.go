@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+	"latihan-compro/config"
+	"latihan-compro/internal/adapter/cache"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestResponseCache(t *testing.T) {
+	cacheAdapter := cache.NewMemory(time.Minute, 100)
+	routes := map[string][]string{
+		"/faq-sections": {"faq_section"},
+		"/site":         {"faq_section"},
+	}
+
+	var calls, partial int
+	e := echo.New()
+	e.Use(ResponseCache(cacheAdapter, &config.Config{}, routes))
+	e.GET("/faq-sections", func(c echo.Context) error {
+		calls++
+		return c.JSON(http.StatusOK, map[string]int{"calls": calls})
+	})
+	e.GET("/site", func(c echo.Context) error {
+		partial++
+		c.Response().Header().Set("Cache-Control", "no-store")
+		return c.JSON(http.StatusOK, map[string]int{"calls": partial})
+	})
+
+	get := func(path string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for name, values := range header {
+			req.Header[name] = values
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := get("/faq-sections", nil)
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || first.Header().Get("X-Cache") != "MISS" || etag == "" {
+		t.Fatalf("first response = %d, X-Cache %q, ETag %q", first.Code, first.Header().Get("X-Cache"), etag)
+	}
+
+	second := get("/faq-sections", nil)
+	if second.Header().Get("X-Cache") != "HIT" || second.Body.String() != first.Body.String() || calls != 1 {
+		t.Fatalf("second response = X-Cache %q, body %s after %d calls, want a hit", second.Header().Get("X-Cache"), second.Body, calls)
+	}
+
+	if rec := get("/faq-sections?lang=en", nil); rec.Header().Get("X-Cache") != "MISS" {
+		t.Errorf("another query string got X-Cache %q, want its own key", rec.Header().Get("X-Cache"))
+	}
+
+	if rec := get("/faq-sections", http.Header{"If-None-Match": {etag}}); rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("conditional request = %d with %d bytes, want 304 without body", rec.Code, rec.Body.Len())
+	}
+
+	if err := cacheAdapter.Invalidate(context.Background(), "faq_section"); err != nil {
+		t.Fatal(err)
+	}
+	before := calls
+	if rec := get("/faq-sections", http.Header{"If-None-Match": {etag}}); rec.Code != http.StatusOK || calls != before+1 {
+		t.Errorf("after invalidation = %d after %d calls, want a fresh 200", rec.Code, calls)
+	}
+
+	for i := 0; i < 2; i++ {
+		rec := get("/site", nil)
+		if rec.Header().Get("X-Cache") != "" || rec.Header().Get("Cache-Control") != "no-store" {
+			t.Errorf("no-store response = X-Cache %q, Cache-Control %q", rec.Header().Get("X-Cache"), rec.Header().Get("Cache-Control"))
+		}
+	}
+	if partial != 2 {
+		t.Errorf("no-store handler ran %d times, want every request", partial)
+	}
+}
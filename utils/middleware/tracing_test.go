@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"latihan-compro/utils/tracing"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestTracingContinuesTraceAndTagsLogs(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	globalProvider, globalPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var out bytes.Buffer
+	globalLogger := log.Logger
+	log.Logger = zerolog.New(&out)
+	zerolog.DefaultContextLogger = &log.Logger
+	t.Cleanup(func() {
+		otel.SetTracerProvider(globalProvider)
+		otel.SetTextMapPropagator(globalPropagator)
+		log.Logger = globalLogger
+		zerolog.DefaultContextLogger = nil
+	})
+
+	e := echo.New()
+	e.Use(Tracing())
+	e.GET("/orders/:id", func(c echo.Context) error {
+		ctx, span := tracing.Start(c.Request().Context(), "OrderService.FetchByIDOrder")
+		defer span.End()
+
+		log.Ctx(ctx).Info().Msg("handler")
+		if c.Param("id") == "broken" {
+			return echo.ErrInternalServerError
+		}
+		return c.String(http.StatusOK, "OK")
+	})
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/orders/broken", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("recorded %d spans, want the service and server spans", len(spans))
+	}
+	child, server := spans[0], spans[1]
+
+	if server.Name() != "GET /orders/:id" {
+		t.Errorf("server span is named %q", server.Name())
+	}
+	if server.SpanContext().TraceID().String() != traceID || server.Parent().SpanID().String() != "00f067aa0ba902b7" {
+		t.Errorf("server span does not continue the traceparent trace")
+	}
+	if child.Parent().SpanID() != server.SpanContext().SpanID() {
+		t.Errorf("service span is not a child of the server span")
+	}
+	if server.Status().Code != codes.Error {
+		t.Errorf("server span status = %v, want an error", server.Status())
+	}
+	var status int64
+	for _, attr := range server.Attributes() {
+		if attr.Key == semconv.HTTPResponseStatusCodeKey {
+			status = attr.Value.AsInt64()
+		}
+	}
+	if status != http.StatusInternalServerError {
+		t.Errorf("server span status code = %d, want 500", status)
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(out.Bytes(), &entry); err != nil {
+		t.Fatalf("decoding %s: %v", out.String(), err)
+	}
+	if entry["trace_id"] != traceID {
+		t.Errorf("log trace_id = %v, want %s", entry["trace_id"], traceID)
+	}
+}
@@ -0,0 +1,341 @@
+package openapi
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+// DocsPage renders the document served at /openapi.json with Swagger UI.
+//
+//go:embed docs.html
+var DocsPage []byte
+
+//go:embed docs.js
+var docsScript embed.FS
+
+// DocsAssets holds the files DocsPage loads, served under /docs/. Swagger UI
+// is embedded from the release pinned in go.mod, so the page works offline
+// and under a Content-Security-Policy of 'self'.
+var DocsAssets fs.FS = docsAssets{}
+
+type docsAssets struct{}
+
+func (docsAssets) Open(name string) (fs.File, error) {
+	switch name {
+	case "docs.js":
+		return docsScript.Open(name)
+	case "swagger-ui.css", "swagger-ui-bundle.js":
+		return swaggerFiles.FS.Open(name)
+	default:
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+}
+
+const Version = "3.0.3"
+
+type Document struct {
+	OpenApi    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+	Tags       []Tag               `json:"tags,omitempty"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// PathItem maps a lower case http method to its operation.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	OperationID string                `json:"operationId"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required,omitempty"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+// Param documents a query or header parameter, path parameters are read from
+// the route path.
+type Param struct {
+	Name        string
+	In          string
+	Description string
+	Required    bool
+	Type        string
+}
+
+// FormField documents a multipart form field.
+type FormField struct {
+	Name     string
+	File     bool
+	Required bool
+}
+
+// Route documents one registered route.
+type Route struct {
+	Method  string
+	Path    string
+	Tag     string
+	Summary string
+	// Auth marks routes behind the bearer token middleware.
+	Auth   bool
+	Params []Param
+	// Body is the request struct bound from a json body.
+	Body any
+	// Form lists the fields of a multipart body.
+	Form []FormField
+	// RawBody is the content type of a body read as is.
+	RawBody string
+	// Data is the value put in the data field of a success response, nil
+	// when the response carries none.
+	Data      any
+	Paginated bool
+	// Status of a success response, 200 when unset.
+	Status int
+	// RawResponse is the content type of a success response written as is,
+	// it has no json envelope.
+	RawResponse string
+	// Unwrapped marks json success responses without the envelope, Data is
+	// the whole body.
+	Unwrapped bool
+	// NoContent marks success responses without a body.
+	NoContent bool
+}
+
+// Key identifies a route by method and echo path.
+func (r Route) Key() string {
+	return r.Method + " " + r.Path
+}
+
+// Envelope holds the response structs every json response is wrapped in.
+type Envelope struct {
+	// Meta is the meta field of a success response.
+	Meta any
+	// Pagination is the pagination field of a paginated success response.
+	Pagination any
+	// Error is the body of an error response.
+	Error any
+}
+
+var pathParamRe = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// SkipRoute reports whether an echo route is left out of the document, those
+// are the not found handlers echo registers for groups and wildcard routes
+// serving static files.
+func SkipRoute(route *echo.Route) bool {
+	return route.Method == echo.RouteNotFound || strings.HasSuffix(route.Path, "*")
+}
+
+// Build returns the document of every registered route that is documented in
+// routes. Undocumented routes are left out, the drift test reports them.
+func Build(info Info, registered []*echo.Route, routes []Route, envelope Envelope) *Document {
+	gen := newGenerator()
+
+	documented := map[string]Route{}
+	for _, route := range routes {
+		documented[route.Key()] = route
+	}
+
+	doc := &Document{
+		OpenApi: Version,
+		Info:    info,
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas: gen.schemas,
+			SecuritySchemes: map[string]SecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+
+	errorSchema := gen.schema(envelope.Error)
+	tags := map[string]bool{}
+	seen := map[string]bool{}
+	for _, val := range registered {
+		route, ok := documented[val.Method+" "+val.Path]
+		if !ok || SkipRoute(val) || seen[route.Key()] {
+			continue
+		}
+		seen[route.Key()] = true
+
+		path := pathParamRe.ReplaceAllString(route.Path, "{$1}")
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = PathItem{}
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = gen.operation(route, envelope, errorSchema)
+
+		if route.Tag != "" {
+			tags[route.Tag] = true
+		}
+	}
+
+	for tag := range tags {
+		doc.Tags = append(doc.Tags, Tag{Name: tag})
+	}
+	sort.Slice(doc.Tags, func(i, j int) bool { return doc.Tags[i].Name < doc.Tags[j].Name })
+
+	return doc
+}
+
+func (g *generator) operation(route Route, envelope Envelope, errorSchema *Schema) *Operation {
+	op := &Operation{
+		Summary:     route.Summary,
+		OperationID: operationID(route),
+		Responses:   map[string]Response{},
+	}
+	if route.Tag != "" {
+		op.Tags = []string{route.Tag}
+	}
+	if route.Auth {
+		op.Security = []map[string][]string{{"bearerAuth": {}}}
+	}
+
+	for _, name := range pathParamRe.FindAllStringSubmatch(route.Path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     name[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	for _, param := range route.Params {
+		paramType := param.Type
+		if paramType == "" {
+			paramType = "string"
+		}
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        param.Name,
+			In:          param.In,
+			Description: param.Description,
+			Required:    param.Required,
+			Schema:      &Schema{Type: paramType},
+		})
+	}
+
+	switch {
+	case route.Body != nil:
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{echo.MIMEApplicationJSON: {Schema: g.schema(route.Body)}},
+		}
+	case len(route.Form) > 0:
+		form := &Schema{Type: "object", Properties: map[string]*Schema{}}
+		for _, field := range route.Form {
+			form.Properties[field.Name] = &Schema{Type: "string"}
+			if field.File {
+				form.Properties[field.Name].Format = "binary"
+			}
+			if field.Required {
+				form.Required = append(form.Required, field.Name)
+			}
+		}
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{echo.MIMEMultipartForm: {Schema: form}},
+		}
+	case route.RawBody != "":
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{route.RawBody: {Schema: &Schema{Type: "string", Format: "binary"}}},
+		}
+	}
+
+	status := route.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	success := Response{Description: http.StatusText(status)}
+	switch {
+	case route.NoContent:
+	case route.RawResponse != "":
+		success.Content = map[string]MediaType{route.RawResponse: {Schema: &Schema{Type: "string", Format: "binary"}}}
+	case route.Unwrapped:
+		success.Content = map[string]MediaType{echo.MIMEApplicationJSON: {Schema: g.schema(route.Data)}}
+	default:
+		body := &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"meta": g.schema(envelope.Meta),
+			},
+		}
+		if route.Data != nil {
+			body.Properties["data"] = g.schema(route.Data)
+		}
+		if route.Paginated {
+			body.Properties["pagination"] = g.schema(envelope.Pagination)
+		}
+		success.Content = map[string]MediaType{echo.MIMEApplicationJSON: {Schema: body}}
+	}
+	op.Responses[strconv.Itoa(status)] = success
+
+	op.Responses["default"] = Response{
+		Description: "Error",
+		Content:     map[string]MediaType{echo.MIMEApplicationJSON: {Schema: errorSchema}},
+	}
+
+	return op
+}
+
+// operationID derives a unique id such as getHeroSectionsAdminById.
+func operationID(route Route) string {
+	id := strings.ToLower(route.Method)
+	for _, part := range strings.FieldsFunc(route.Path, func(r rune) bool { return r == '/' || r == '-' || r == '_' }) {
+		if name, ok := strings.CutPrefix(part, ":"); ok {
+			part = "by" + strings.ToUpper(name[:1]) + name[1:]
+		}
+		id += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return id
+}
@@ -0,0 +1,39 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestEndRecordsReturnedError(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	method := func(fail bool) (err error) {
+		_, span := tracer.Start(context.Background(), "Service.Method")
+		defer End(span, &err)
+
+		if fail {
+			return errors.New("boom")
+		}
+		return nil
+	}
+	_ = method(true)
+	_ = method(false)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("got %d ended spans, want 2", len(spans))
+	}
+	if got := spans[0].Status(); got.Code != codes.Error || got.Description != "boom" || len(spans[0].Events()) != 1 {
+		t.Errorf("failed span status = %+v with %d events, want the error recorded", got, len(spans[0].Events()))
+	}
+	if got := spans[1].Status(); got.Code != codes.Unset || len(spans[1].Events()) != 0 {
+		t.Errorf("successful span status = %+v with %d events, want none", got, len(spans[1].Events()))
+	}
+}
@@ -0,0 +1,165 @@
+package upload
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"io"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	"github.com/gen2brain/webp"
+)
+
+const (
+	FormatJPEG = "jpeg"
+	FormatWebP = "webp"
+
+	// webpQuality matches the visual quality of JPEGQuality(85) at a
+	// smaller size.
+	webpQuality = 80
+
+	// LargeVariant is the variant stored in single path columns such as
+	// our_teams.path_photo; the rest of the set is derived from its name.
+	LargeVariant = "large"
+)
+
+// imageSizes are the variants generated for every processed image, bounded
+// to a square box so portrait and landscape photos get the same budget.
+var imageSizes = []struct {
+	Name string
+	Box  int
+}{
+	{Name: "thumbnail", Box: 320},
+	{Name: "medium", Box: 800},
+	{Name: LargeVariant, Box: 1600},
+}
+
+var imageFormats = []struct {
+	Name        string
+	Extension   string
+	ContentType string
+}{
+	{Name: FormatJPEG, Extension: ".jpg", ContentType: "image/jpeg"},
+	{Name: FormatWebP, Extension: ".webp", ContentType: "image/webp"},
+}
+
+type ImageVariant struct {
+	Size        string
+	Format      string
+	Extension   string
+	ContentType string
+	Width       int64
+	Height      int64
+	Data        *bytes.Buffer
+}
+
+// IsProcessableImage reports whether uploads of contentType go through the
+// image pipeline. Animated formats like GIF are stored as they are.
+func IsProcessableImage(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/webp":
+		return true
+	default:
+		return false
+	}
+}
+
+// ProcessImage decodes the image, rotates it upright according to its EXIF
+// orientation and encodes every size in every format. Re-encoding drops all
+// metadata, EXIF included. Images are never upscaled. The dimensions are read
+// from the header first, images of more than maxPixels are rejected before
+// their pixels are allocated.
+func ProcessImage(src io.ReadSeeker, maxPixels int64) ([]ImageVariant, error) {
+	config, _, err := image.DecodeConfig(src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	if pixels := int64(config.Width) * int64(config.Height); pixels > maxPixels {
+		return nil, fmt.Errorf("%w: %dx%d is more than %d pixels", ErrImageTooLarge, config.Width, config.Height, maxPixels)
+	}
+
+	if _, err = src.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	img, err := imaging.Decode(src, imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+
+	var variants []ImageVariant
+	for _, size := range imageSizes {
+		resized := imaging.Fit(img, size.Box, size.Box, imaging.Lanczos)
+
+		for _, format := range imageFormats {
+			data, err := encodeImage(resized, format.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			variants = append(variants, ImageVariant{
+				Size:        size.Name,
+				Format:      format.Name,
+				Extension:   format.Extension,
+				ContentType: format.ContentType,
+				Width:       int64(resized.Bounds().Dx()),
+				Height:      int64(resized.Bounds().Dy()),
+				Data:        data,
+			})
+		}
+	}
+
+	return variants, nil
+}
+
+// ImageSize reads the dimensions of images that skip the pipeline, like GIF.
+func ImageSize(src io.Reader) (int64, int64, bool) {
+	config, _, err := image.DecodeConfig(src)
+	if err != nil {
+		return 0, 0, false
+	}
+	return int64(config.Width), int64(config.Height), true
+}
+
+func encodeImage(img *image.NRGBA, format string) (*bytes.Buffer, error) {
+	data := new(bytes.Buffer)
+	if format == FormatWebP {
+		// webp runs libwebp as WebAssembly when the system has no libwebp,
+		// so no cgo is needed.
+		return data, webp.Encode(data, img, webp.Options{Quality: webpQuality})
+	}
+
+	// JPEG has no alpha channel, transparent pixels are laid on white.
+	background := imaging.New(img.Bounds().Dx(), img.Bounds().Dy(), color.White)
+	flattened := imaging.Overlay(background, img, image.Pt(0, 0), 1)
+	return data, imaging.Encode(data, flattened, imaging.JPEG, imaging.JPEGQuality(85))
+}
+
+// VariantPath returns where the variant of the upload at basePath is stored,
+// e.g. public/uploads/photo_medium.webp.
+func VariantPath(basePath string, variant ImageVariant) string {
+	return basePath + "_" + variant.Size + variant.Extension
+}
+
+// ImageVariants derives the whole variant set from the URL of a large JPEG
+// variant, keyed by size then format. It returns nil for any other URL, like
+// images uploaded before the pipeline existed.
+func ImageVariants(url string) map[string]map[string]string {
+	suffix := "_" + LargeVariant + imageFormats[0].Extension
+	basePath, ok := strings.CutSuffix(url, suffix)
+	if !ok {
+		return nil
+	}
+
+	variants := map[string]map[string]string{}
+	for _, size := range imageSizes {
+		variants[size.Name] = map[string]string{}
+		for _, format := range imageFormats {
+			variants[size.Name][format.Name] = basePath + "_" + size.Name + format.Extension
+		}
+	}
+	return variants
+}
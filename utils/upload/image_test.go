@@ -0,0 +1,63 @@
+package upload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: uint8(x * y), A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestProcessImageRejectsTooManyPixels(t *testing.T) {
+	// A tiny file whose header claims 50000x50000 pixels, decoding it would
+	// allocate gigabytes.
+	data := encodePNG(t, 1, 1)
+	ihdr := data[8+8 : 8+8+13]
+	binary.BigEndian.PutUint32(ihdr[0:4], 50000)
+	binary.BigEndian.PutUint32(ihdr[4:8], 50000)
+	binary.BigEndian.PutUint32(data[8+8+13:], crc32.ChecksumIEEE(data[8+4:8+8+13]))
+
+	_, err := ProcessImage(bytes.NewReader(data), defaultMaxImagePixels)
+	if !errors.Is(err, ErrImageTooLarge) {
+		t.Fatalf("ProcessImage() = %v, want ErrImageTooLarge", err)
+	}
+}
+
+func TestProcessImageEncodesLossyWebP(t *testing.T) {
+	variants, err := ProcessImage(bytes.NewReader(encodePNG(t, 400, 300)), defaultMaxImagePixels)
+	if err != nil {
+		t.Fatalf("ProcessImage() = %v", err)
+	}
+
+	sizes := map[string]int{}
+	for _, val := range variants {
+		if val.Size != LargeVariant {
+			continue
+		}
+		sizes[val.Format] = val.Data.Len()
+		if val.Width != 400 || val.Height != 300 {
+			t.Errorf("large %s variant is %dx%d, want 400x300 as images are never upscaled", val.Format, val.Width, val.Height)
+		}
+	}
+	if sizes[FormatWebP] == 0 || sizes[FormatWebP] >= sizes[FormatJPEG] {
+		t.Errorf("webp variant is %d bytes, want less than the %d bytes jpeg", sizes[FormatWebP], sizes[FormatJPEG])
+	}
+}
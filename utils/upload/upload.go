@@ -0,0 +1,201 @@
+package upload
+
+import (
+	"errors"
+	"fmt"
+	"latihan-compro/config"
+	"mime/multipart"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gabriel-vasile/mimetype"
+)
+
+var (
+	ErrTypeNotAllowed = errors.New("file type is not allowed")
+	ErrFileTooLarge   = errors.New("file is too large")
+	ErrInvalidImage   = errors.New("file is not a valid image")
+	ErrImageTooLarge  = errors.New("image has too many pixels")
+)
+
+// defaultAllowedTypes is used when UPLOAD_ALLOWED_TYPES is not set.
+var defaultAllowedTypes = map[string]int64{
+	"image/jpeg": 5 << 20,
+	"image/png":  5 << 20,
+	"image/webp": 5 << 20,
+	"image/gif":  5 << 20,
+	"video/mp4":  100 << 20,
+	"video/webm": 100 << 20,
+}
+
+// defaultMaxImagePixels is used when UPLOAD_MAX_IMAGE_PIXELS is not set, a
+// decoded image takes 4 bytes per pixel.
+const defaultMaxImagePixels = 40_000_000
+
+// defaultDocumentTypes is used when UPLOAD_DOCUMENT_TYPES is not set.
+var defaultDocumentTypes = map[string]int64{
+	"application/pdf": 10 << 20,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": 10 << 20,
+}
+
+type File struct {
+	multipart.File
+	ContentType string
+	Extension   string
+	Size        int64
+}
+
+type Policy struct {
+	allowedTypes   map[string]int64
+	maxImagePixels int64
+}
+
+// Open opens the uploaded file once its content, sniffed from the magic bytes
+// rather than trusted from the filename or the request, is an allowed type
+// within its size limit. The caller must close the returned file.
+func (p *Policy) Open(header *multipart.FileHeader) (*File, error) {
+	src, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	detected, err := mimetype.DetectReader(src)
+	if err != nil {
+		src.Close()
+		return nil, err
+	}
+
+	if _, err = src.Seek(0, 0); err != nil {
+		src.Close()
+		return nil, err
+	}
+
+	contentType, err := p.check(detected, header.Size)
+	if err != nil {
+		src.Close()
+		return nil, err
+	}
+
+	return &File{
+		File:        src,
+		ContentType: contentType,
+		Extension:   detected.Extension(),
+		Size:        header.Size,
+	}, nil
+}
+
+// Detect applies the same checks to files arriving in chunks, given their
+// leading bytes and declared total size. It returns the MIME type and the
+// extension of the content.
+func (p *Policy) Detect(head []byte, size int64) (string, string, error) {
+	detected := mimetype.Detect(head)
+	contentType, err := p.check(detected, size)
+	if err != nil {
+		return "", "", err
+	}
+	return contentType, detected.Extension(), nil
+}
+
+// Allow checks a declared type and size before the file itself is seen, as
+// for direct uploads. It returns the extension files of that type get.
+func (p *Policy) Allow(contentType string, size int64) (string, error) {
+	detected := mimetype.Lookup(contentType)
+	if detected == nil {
+		return "", fmt.Errorf("%w: %s", ErrTypeNotAllowed, contentType)
+	}
+
+	if _, err := p.check(detected, size); err != nil {
+		return "", err
+	}
+	return detected.Extension(), nil
+}
+
+// MaxSize returns the largest size any allowed type may have.
+func (p *Policy) MaxSize() int64 {
+	var maxSize int64
+	for _, limit := range p.allowedTypes {
+		maxSize = max(maxSize, limit)
+	}
+	return maxSize
+}
+
+// AllowedTypes returns every accepted MIME type with its size limit.
+func (p *Policy) AllowedTypes() map[string]int64 {
+	return p.allowedTypes
+}
+
+func (p *Policy) match(detected *mimetype.MIME) (string, int64, bool) {
+	for contentType, limit := range p.allowedTypes {
+		if detected.Is(contentType) {
+			return contentType, limit, true
+		}
+	}
+	return "", 0, false
+}
+
+// MaxImagePixels returns the most pixels an image may have to be processed.
+func (p *Policy) MaxImagePixels() int64 {
+	return p.maxImagePixels
+}
+
+// Limit returns the size limit of an allowed type.
+func (p *Policy) Limit(contentType string) int64 {
+	return p.allowedTypes[contentType]
+}
+
+func (p *Policy) check(detected *mimetype.MIME, size int64) (string, error) {
+	contentType, limit, ok := p.match(detected)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrTypeNotAllowed, detected.String())
+	}
+
+	if size > limit {
+		return "", fmt.Errorf("%w: %s files may be at most %s", ErrFileTooLarge, contentType, humanize.IBytes(uint64(limit)))
+	}
+	return contentType, nil
+}
+
+func NewPolicy(cfg *config.Config) *Policy {
+	allowedTypes := cfg.Upload.AllowedTypes
+	if len(allowedTypes) == 0 {
+		allowedTypes = defaultAllowedTypes
+	}
+
+	maxImagePixels := cfg.Upload.MaxImagePixels
+	if maxImagePixels <= 0 {
+		maxImagePixels = defaultMaxImagePixels
+	}
+
+	return &Policy{
+		allowedTypes:   allowedTypes,
+		maxImagePixels: maxImagePixels,
+	}
+}
+
+// NewDocumentPolicy returns the policy for service detail documents, PDF and
+// DOCX by default. A DOCX is told apart from any other zip by its content.
+func NewDocumentPolicy(cfg *config.Config) *Policy {
+	documentTypes := cfg.Upload.DocumentTypes
+	if len(documentTypes) == 0 {
+		documentTypes = defaultDocumentTypes
+	}
+
+	return &Policy{
+		allowedTypes: documentTypes,
+	}
+}
+
+// NewVideoPolicy returns the policy for chunked video uploads, the video types
+// of the regular upload policy.
+func NewVideoPolicy(cfg *config.Config) *Policy {
+	videoTypes := map[string]int64{}
+	for contentType, limit := range NewPolicy(cfg).allowedTypes {
+		if strings.HasPrefix(contentType, "video/") {
+			videoTypes[contentType] = limit
+		}
+	}
+
+	return &Policy{
+		allowedTypes: videoTypes,
+	}
+}
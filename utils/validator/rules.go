@@ -0,0 +1,111 @@
+package validator
+
+import (
+	"reflect"
+	"regexp"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// DateLayout is the layout of date fields, the future rule parses strings
+// with it unless the rule has a layout param.
+const DateLayout = "2006-01-02"
+
+var (
+	phoneRe       = regexp.MustCompile(`^\+?[0-9 ().-]+$`)
+	phoneDigitsRe = regexp.MustCompile(`[0-9]`)
+)
+
+// ruleMessages holds the message of every custom rule per locale.
+var ruleMessages = map[string]map[string]string{
+	"phone": {
+		"en": "{0} must be a valid phone number",
+		"id": "{0} harus berupa nomor telepon yang valid",
+	},
+	"future": {
+		"en": "{0} must be a date in the future",
+		"id": "{0} harus berupa tanggal di masa depan",
+	},
+	"positive": {
+		"en": "{0} must be a positive number",
+		"id": "{0} harus berupa angka positif",
+	},
+}
+
+func registerRules(validate *validator.Validate) {
+	validate.RegisterValidation("phone", isPhone)
+	validate.RegisterValidation("future", isFuture)
+	validate.RegisterValidation("positive", isPositive)
+}
+
+func registerRuleTranslations(validate *validator.Validate, translators map[string]ut.Translator) error {
+	for rule, messages := range ruleMessages {
+		for locale, trans := range translators {
+			message, ok := messages[locale]
+			if !ok {
+				continue
+			}
+
+			err := validate.RegisterTranslation(rule, trans, func(ut ut.Translator) error {
+				return ut.Add(rule, message, true)
+			}, func(ut ut.Translator, fe validator.FieldError) string {
+				translated, _ := ut.T(fe.Tag(), fe.Field())
+				return translated
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isPhone accepts 8 to 15 digits with an optional leading plus, separated by
+// spaces, dots, dashes or parentheses, e.g. +62 812-3456-7890.
+func isPhone(fl validator.FieldLevel) bool {
+	phone := fl.Field().String()
+	if !phoneRe.MatchString(phone) {
+		return false
+	}
+	digits := len(phoneDigitsRe.FindAllString(phone, -1))
+	return digits >= 8 && digits <= 15
+}
+
+// isFuture accepts a time.Time, or a string in the layout given as param
+// (DateLayout by default), that is after now.
+func isFuture(fl validator.FieldLevel) bool {
+	field := fl.Field()
+
+	if t, ok := field.Interface().(time.Time); ok {
+		return t.After(time.Now())
+	}
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	layout := fl.Param()
+	if layout == "" {
+		layout = DateLayout
+	}
+	t, err := time.Parse(layout, field.String())
+	if err != nil {
+		return false
+	}
+	return t.After(time.Now())
+}
+
+func isPositive(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int() > 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return field.Uint() > 0
+	case reflect.Float32, reflect.Float64:
+		return field.Float() > 0
+	default:
+		return false
+	}
+}
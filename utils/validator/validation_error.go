@@ -0,0 +1,55 @@
+package validator
+
+import (
+	"latihan-compro/internal/core/domain/errs"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError is one failed rule, Field is the json name of the field.
+type FieldError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+// ValidationError lists every failed rule of a request. It is an
+// errs.ErrValidation, so it maps to 400 like other validation errors.
+type ValidationError struct {
+	fields    validator.ValidationErrors
+	validator *Validator
+}
+
+// Error joins the messages in the default locale.
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.fields))
+	for _, val := range e.Fields(Locales[0]) {
+		messages = append(messages, val.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return errs.ErrValidation
+}
+
+// Fields returns the failed rules with their messages translated to locale.
+func (e *ValidationError) Fields(locale string) []FieldError {
+	trans := e.validator.translator(locale)
+
+	fields := make([]FieldError, 0, len(e.fields))
+	for _, val := range e.fields {
+		// The namespace starts with the name of the validated struct.
+		_, field, _ := strings.Cut(val.Namespace(), ".")
+		if field == "" {
+			field = val.Field()
+		}
+		fields = append(fields, FieldError{
+			Field:   field,
+			Rule:    val.Tag(),
+			Message: val.Translate(trans),
+		})
+	}
+	return fields
+}
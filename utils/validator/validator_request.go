@@ -1,45 +1,121 @@
 package validator
 
 import (
-	"errors"
-	"log"
+	"reflect"
+	"strings"
 
 	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/id"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	enTranslations "github.com/go-playground/validator/v10/translations/en"
+	idTranslations "github.com/go-playground/validator/v10/translations/id"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/text/language"
 )
 
+// Locales lists the locales validation messages are translated to, the first
+// one is the default.
+var Locales = []string{"en", "id"}
+
+var localeMatcher = newLocaleMatcher()
+
 type Validator struct {
-	Validator  *validator.Validate
-	Translator ut.Translator
+	Validator *validator.Validate
+	// Translator translates to the default locale.
+	Translator  ut.Translator
+	translators map[string]ut.Translator
 }
 
 func NewValidator() *Validator {
-	en := en.New()
-	uni := ut.New(en, en)
-	trans, found := uni.GetTranslator("en")
-	if !found {
-		log.Fatal("translator not found")
-	}
+	uni := ut.New(en.New(), en.New(), id.New())
 
 	validate := validator.New()
+	// Report fields by the name clients send them with.
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+	registerRules(validate)
+
+	translators := map[string]ut.Translator{}
+	for _, locale := range Locales {
+		trans, found := uni.GetTranslator(locale)
+		if !found {
+			log.Fatal().Msgf("[VALIDATOR] NewValidator - 1: translator %s not found", locale)
+		}
+		translators[locale] = trans
+	}
+
+	if err := enTranslations.RegisterDefaultTranslations(validate, translators["en"]); err != nil {
+		log.Fatal().Err(err).Msg("[VALIDATOR] NewValidator - 2")
+	}
+	if err := idTranslations.RegisterDefaultTranslations(validate, translators["id"]); err != nil {
+		log.Fatal().Err(err).Msg("[VALIDATOR] NewValidator - 3")
+	}
+	if err := registerRuleTranslations(validate, translators); err != nil {
+		log.Fatal().Err(err).Msg("[VALIDATOR] NewValidator - 4")
+	}
 
 	return &Validator{
-		Validator:  validate,
-		Translator: trans,
+		Validator:   validate,
+		Translator:  translators[Locales[0]],
+		translators: translators,
 	}
 }
 
+// Validate returns a *ValidationError listing every failed rule of i.
 func (v *Validator) Validate(i interface{}) error {
 	err := v.Validator.Struct(i)
+	if err == nil {
+		return nil
+	}
 
-	if err != nil {
-		object, _ := err.(validator.ValidationErrors)
+	fields, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return err
+	}
+	return &ValidationError{fields: fields, validator: v}
+}
 
-		for _, key := range object {
-			return errors.New(key.Translate(v.Translator))
+// MatchLocale returns the supported locale closest to the lang query param,
+// then to the Accept-Language header, falling back to the default locale.
+func MatchLocale(lang, acceptLanguage string) string {
+	if lang != "" {
+		if tag, err := language.Parse(lang); err == nil {
+			if _, index, confidence := localeMatcher.Match(tag); confidence != language.No {
+				return Locales[index]
+			}
 		}
 	}
 
-	return nil
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Locales[0]
+	}
+	if _, index, confidence := localeMatcher.Match(tags...); confidence != language.No {
+		return Locales[index]
+	}
+	return Locales[0]
+}
+
+func (v *Validator) translator(locale string) ut.Translator {
+	if trans, ok := v.translators[locale]; ok {
+		return trans
+	}
+	return v.Translator
+}
+
+func newLocaleMatcher() language.Matcher {
+	tags := make([]language.Tag, 0, len(Locales))
+	for _, locale := range Locales {
+		tags = append(tags, language.Make(locale))
+	}
+	return language.NewMatcher(tags)
 }
@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"errors"
+	"latihan-compro/internal/core/domain/errs"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testRequest struct {
+	PhoneNumber string  `json:"phone_number" validate:"required,phone"`
+	ProjectUrl  string  `json:"project_url" validate:"omitempty,url"`
+	Budget      float64 `json:"budget" validate:"required,positive"`
+	MeetAt      string  `json:"meet_at" validate:"required,future"`
+}
+
+func validRequest() testRequest {
+	return testRequest{
+		PhoneNumber: "+62 812-3456-7890",
+		ProjectUrl:  "https://example.com/project",
+		Budget:      1500,
+		MeetAt:      time.Now().AddDate(0, 0, 2).Format(DateLayout),
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	v := NewValidator()
+
+	tests := []struct {
+		name   string
+		modify func(req *testRequest)
+		field  string
+		rule   string
+	}{
+		{"valid", func(req *testRequest) {}, "", ""},
+		{"local phone", func(req *testRequest) { req.PhoneNumber = "081234567890" }, "", ""},
+		{"phone with letters", func(req *testRequest) { req.PhoneNumber = "0812-CALL-ME" }, "phone_number", "phone"},
+		{"phone too short", func(req *testRequest) { req.PhoneNumber = "12345" }, "phone_number", "phone"},
+		{"project url", func(req *testRequest) { req.ProjectUrl = "not a url" }, "project_url", "url"},
+		{"negative budget", func(req *testRequest) { req.Budget = -10 }, "budget", "positive"},
+		{"past meeting", func(req *testRequest) { req.MeetAt = "2020-01-01" }, "meet_at", "future"},
+		{"today meeting", func(req *testRequest) { req.MeetAt = time.Now().Format(DateLayout) }, "meet_at", "future"},
+		{"malformed meeting", func(req *testRequest) { req.MeetAt = "tomorrow" }, "meet_at", "future"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validRequest()
+			tt.modify(&req)
+
+			err := v.Validate(req)
+			if tt.field == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() = %v, want a *ValidationError", err)
+			}
+			fields := validationErr.Fields("en")
+			if len(fields) != 1 || fields[0].Field != tt.field || fields[0].Rule != tt.rule {
+				t.Errorf("Fields() = %+v, want one %s error on %s", fields, tt.rule, tt.field)
+			}
+		})
+	}
+}
+
+func TestValidationErrorFields(t *testing.T) {
+	v := NewValidator()
+
+	err := v.Validate(testRequest{Budget: -1, MeetAt: "2020-01-01", PhoneNumber: "abc"})
+	if !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("Validate() = %v, want an errs.ErrValidation", err)
+	}
+
+	var validationErr *ValidationError
+	errors.As(err, &validationErr)
+
+	want := map[string][]FieldError{
+		"en": {
+			{Field: "phone_number", Rule: "phone", Message: "phone_number must be a valid phone number"},
+			{Field: "budget", Rule: "positive", Message: "budget must be a positive number"},
+			{Field: "meet_at", Rule: "future", Message: "meet_at must be a date in the future"},
+		},
+		"id": {
+			{Field: "phone_number", Rule: "phone", Message: "phone_number harus berupa nomor telepon yang valid"},
+			{Field: "budget", Rule: "positive", Message: "budget harus berupa angka positif"},
+			{Field: "meet_at", Rule: "future", Message: "meet_at harus berupa tanggal di masa depan"},
+		},
+	}
+	for locale, fields := range want {
+		if got := validationErr.Fields(locale); !reflect.DeepEqual(got, fields) {
+			t.Errorf("Fields(%q) = %+v, want %+v", locale, got, fields)
+		}
+	}
+}
+
+func TestMatchLocale(t *testing.T) {
+	tests := []struct {
+		lang, acceptLanguage, want string
+	}{
+		{"", "", "en"},
+		{"id", "", "id"},
+		{"", "id-ID,id;q=0.9,en;q=0.8", "id"},
+		{"en", "id-ID", "en"},
+		{"", "fr-FR", "en"},
+	}
+	for _, tt := range tests {
+		if got := MatchLocale(tt.lang, tt.acceptLanguage); got != tt.want {
+			t.Errorf("MatchLocale(%q, %q) = %q, want %q", tt.lang, tt.acceptLanguage, got, tt.want)
+		}
+	}
+}